@@ -79,9 +79,10 @@ var (
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
 		gov.ModuleName:            {supply.Burner},
 		// trustory module accounts
-		trudist.UserGrowthPoolName:    {supply.Minter, supply.Burner},
-		trudist.UserRewardPoolName:    {supply.Minter, supply.Burner},
-		trustaking.UserStakesPoolName: {supply.Minter, supply.Burner},
+		trudist.UserGrowthPoolName:      {supply.Minter, supply.Burner},
+		trudist.UserRewardPoolName:      {supply.Minter, supply.Burner},
+		trustaking.UserStakesPoolName:   {supply.Minter, supply.Burner},
+		claim.UserClaimDepositsPoolName: {supply.Minter, supply.Burner},
 	}
 )
 
@@ -225,24 +226,33 @@ func NewTruChain(logger log.Logger, db dbm.DB, loadLatest bool,
 		app.supplyKeeper,
 	)
 
-	app.claimKeeper = claim.NewKeeper(
+	claimKeeper := claim.NewKeeper(
 		keys[claim.StoreKey],
 		app.paramsKeeper.Subspace(claim.StoreKey),
 		codec,
 		app.appAccountKeeper,
+		app.truBankKeeper,
+		app.supplyKeeper,
 		app.communityKeeper,
 	)
 
-	app.truStakingKeeper = trustaking.NewKeeper(
+	truStakingKeeper := trustaking.NewKeeper(
 		codec,
 		keys[trustaking.StoreKey],
 		app.appAccountKeeper,
 		app.truBankKeeper,
-		app.claimKeeper,
+		claimKeeper,
 		app.supplyKeeper,
 		truStakingSubspace,
 		trustaking.DefaultCodespace,
 	)
+	// no module reacts to arguments and stakes yet, one that does sets its hooks here
+	// with truStakingKeeper.SetHooks, before the keeper is copied into the modules depending on it
+	app.truStakingKeeper = truStakingKeeper
+	// the claim hooks cascade removed claims to the modules holding stakes on them
+	app.claimKeeper = *claimKeeper.SetHooks(
+		claim.NewMultiClaimHooks(app.truStakingKeeper.Hooks()),
+	)
 
 	app.truSlashingKeeper = truslashing.NewKeeper(
 		keys[truslashing.StoreKey],
@@ -289,7 +299,7 @@ func NewTruChain(logger log.Logger, db dbm.DB, loadLatest bool,
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
 	app.mm.SetOrderBeginBlockers(mint.ModuleName, trudist.ModuleName, distr.ModuleName, slashing.ModuleName)
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, trustaking.ModuleName, truslashing.ModuleName, account.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, trustaking.ModuleName, claim.ModuleName, truslashing.ModuleName, account.ModuleName)
 
 	// genutils must occur after staking so that pools are properly
 	// initialized with tokens from genesis accounts.
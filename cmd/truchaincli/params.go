@@ -34,6 +34,7 @@ func GetParamsCmd(cdc *codec.Codec) *cobra.Command {
 	paramsCmd.AddCommand(CommunityParamsCmd(cdc))
 	paramsCmd.AddCommand(ClaimParamsCmd(cdc))
 	paramsCmd.AddCommand(StakingParamsCmd(cdc))
+	paramsCmd.AddCommand(StakingCommunityParamsCmd(cdc))
 	paramsCmd.AddCommand(SlashingParamsCmd(cdc))
 
 	return paramsCmd
@@ -438,6 +439,10 @@ func StakingParamsCmd(cdc *codec.Codec) *cobra.Command {
 						reflect.ValueOf(&updates).Elem().FieldByName(field.Name).Set(
 							makeCosmosObject(field.Type.String(), cmd.Flag(param).Value.String()),
 						)
+					} else if field.Type == reflect.TypeOf([]staking.StakeTier{}) {
+						reflect.ValueOf(&updates).Elem().FieldByName(field.Name).Set(
+							makeStakeTiers(cmd.Flag(param).Value.String()),
+						)
 					} else {
 						mapInput[param] = input
 					}
@@ -495,6 +500,99 @@ func StakingParamsCmd(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// StakingCommunityParamsCmd commands exposes the commands to override staking params for a community
+func StakingCommunityParamsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staking-community [auth-address] [community-id]",
+		Short: "Override the staking params of a community",
+		Args:  cobra.ExactArgs(2),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+
+			var msg sdk.Msg
+			if remove, _ := cmd.Flags().GetBool(flagRemove); remove {
+				msg = staking.NewMsgRemoveCommunityStakingParams(args[1], cliCtx.GetFromAddress())
+			} else {
+				mapInput := make(map[string]string)
+				updates := staking.CommunityStakingParams{}
+				updatedFields := make([]string, 0)
+				mapCommunityStakingParams(func(param string, field reflect.StructField) {
+					input := cmd.Flag(param).Value.String()
+					if input != "" {
+						if field.Type.PkgPath() == "github.com/cosmos/cosmos-sdk/types" {
+							// if cosmos type, we'll make the cosmos object
+							reflect.ValueOf(&updates).Elem().FieldByName(field.Name).Set(
+								makeCosmosObject(field.Type.String(), cmd.Flag(param).Value.String()),
+							)
+						} else {
+							mapInput[param] = input
+						}
+
+						updatedFields = append(updatedFields, param)
+					}
+				})
+
+				msConfig := &mapstructure.DecoderConfig{
+					TagName:          "json",
+					WeaklyTypedInput: true,
+					Result:           &updates,
+				}
+				decoder, err := mapstructure.NewDecoder(msConfig)
+				if err != nil {
+					panic(err)
+				}
+				err = decoder.Decode(mapInput)
+				if err != nil {
+					panic(err)
+				}
+				msg = staking.NewMsgUpdateCommunityStakingParams(args[1], updates, updatedFields, cliCtx.GetFromAddress())
+			}
+
+			fromName := cliCtx.GetFromName()
+			passphrase, err := keys.GetPassphrase(fromName)
+			if err != nil {
+				return err
+			}
+
+			txBytes, err := txBldr.BuildAndSign(fromName, passphrase, []sdk.Msg{msg})
+			if err != nil {
+				return err
+			}
+
+			// broadcast to a Tendermint node
+			res, err := cliCtx.WithBroadcastMode(client.BroadcastBlock).BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+			fmt.Println(res)
+			return nil
+		},
+	}
+
+	// Adding the available flags
+	mapCommunityStakingParams(func(param string, field reflect.StructField) {
+		cmd.Flags().String(param, "", "Overrides the param: "+param)
+	})
+	cmd.Flags().Bool(flagRemove, false, "Removes the overrides so the community uses the global params")
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+const flagRemove = "remove"
+
+// mapCommunityStakingParams walks over the staking params that can be overridden per community
+func mapCommunityStakingParams(fn func(param string, field reflect.StructField)) {
+	mapParams(staking.CommunityStakingParams{}, func(param string, _ int, field reflect.StructField) {
+		if param != "community_id" && param != "overrides" {
+			fn(param, field)
+		}
+	})
+}
+
 // mapParams walks over each param, and ignores the *_admins param because they are out of scope for this CLI command
 func mapParams(params interface{}, fn func(param string, index int, field reflect.StructField)) {
 	rParams := reflect.TypeOf(params)
@@ -522,6 +620,14 @@ func makeCosmosObject(cosmosType string, value string) reflect.Value {
 		return reflect.ValueOf(coin)
 	}
 
+	if cosmosType == "types.Int" {
+		amount, ok := sdk.NewIntFromString(value)
+		if !ok {
+			panic(fmt.Sprintf("invalid integer: %s", value))
+		}
+		return reflect.ValueOf(amount)
+	}
+
 	if cosmosType == "types.AccAddress" {
 		address, err := sdk.AccAddressFromBech32(value)
 		if err != nil {
@@ -532,3 +638,19 @@ func makeCosmosObject(cosmosType string, value string) reflect.Value {
 
 	return reflect.ValueOf(value)
 }
+
+// makeStakeTiers converts a comma separated list of earned_threshold:max_stake pairs into stake tiers
+func makeStakeTiers(value string) reflect.Value {
+	tiers := make([]staking.StakeTier, 0)
+	for _, pair := range strings.Split(value, ",") {
+		amounts := strings.Split(strings.TrimSpace(pair), ":")
+		if len(amounts) != 2 {
+			panic(fmt.Sprintf("invalid stake tier: %s", pair))
+		}
+		tiers = append(tiers, staking.StakeTier{
+			EarnedThreshold: makeCosmosObject("types.Int", amounts[0]).Interface().(sdk.Int),
+			MaxStake:        makeCosmosObject("types.Int", amounts[1]).Interface().(sdk.Int),
+		})
+	}
+	return reflect.ValueOf(tiers)
+}
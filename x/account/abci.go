@@ -27,6 +27,7 @@ func (k Keeper) unjailAccounts(ctx sdk.Context) {
 			sdk.NewEvent(
 				EventTypeUnjailedAccount,
 				sdk.NewAttribute(AttributeKeyUser, acct.PrimaryAddress().String()),
+				sdk.NewAttribute(AttributeKeyReason, AttributeValueReasonJailEnded),
 			),
 		)
 
@@ -17,6 +17,10 @@ const (
 
 	EventTypeUnjailedAccount = "unjailed_account"
 	AttributeKeyUser         = "user"
+	AttributeKeyReason       = "reason"
+
+	AttributeValueReasonJailEnded = "jail_ended"
+	AttributeValueReasonUpvotes   = "upvotes"
 )
 
 type PrimaryAccount struct {
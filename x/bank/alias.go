@@ -28,7 +28,15 @@ const (
 	TransactionStakeCreatorSlashed             = exported.TransactionStakeCreatorSlashed
 	TransactionStakeCuratorSlashed             = exported.TransactionStakeCuratorSlashed
 
-	TransactionCuratorReward = exported.TransactionCuratorReward
+	TransactionCuratorReward          = exported.TransactionCuratorReward
+	TransactionStakeWithdrawalPenalty = exported.TransactionStakeWithdrawalPenalty
+	TransactionDownvote               = exported.TransactionDownvote
+	TransactionDownvoteReturned       = exported.TransactionDownvoteReturned
+	TransactionDownvoterReward        = exported.TransactionDownvoterReward
+	TransactionClaimCreation          = exported.TransactionClaimCreation
+	TransactionClaimCreationReturned  = exported.TransactionClaimCreationReturned
+	TransactionInterestClaimCreation  = exported.TransactionInterestClaimCreation
+	TransactionStakeForfeited         = exported.TransactionStakeForfeited
 
 	SortAsc                    = exported.SortAsc
 	SortDesc                   = exported.SortDesc
@@ -43,6 +43,14 @@ const (
 	TransactionStakeCreatorSlashed
 	TransactionStakeCuratorSlashed
 	TransactionCuratorReward
+	TransactionStakeWithdrawalPenalty
+	TransactionDownvote
+	TransactionDownvoteReturned
+	TransactionDownvoterReward
+	TransactionClaimCreation
+	TransactionClaimCreationReturned
+	TransactionInterestClaimCreation
+	TransactionStakeForfeited
 )
 
 var TransactionTypeName = []string{
@@ -62,6 +70,15 @@ var TransactionTypeName = []string{
 	TransactionInterestUpvoteGivenSlashed:      "TransactionInterestUpvoteGivenSlashed",
 	TransactionStakeCreatorSlashed:             "TransactionStakeCreatorSlashed",
 	TransactionStakeCuratorSlashed:             "TransactionStakeCuratorSlashed",
+	TransactionCuratorReward:                   "TransactionCuratorReward",
+	TransactionStakeWithdrawalPenalty:          "TransactionStakeWithdrawalPenalty",
+	TransactionDownvote:                        "TransactionDownvote",
+	TransactionDownvoteReturned:                "TransactionDownvoteReturned",
+	TransactionDownvoterReward:                 "TransactionDownvoterReward",
+	TransactionClaimCreation:                   "TransactionClaimCreation",
+	TransactionClaimCreationReturned:           "TransactionClaimCreationReturned",
+	TransactionInterestClaimCreation:           "TransactionInterestClaimCreation",
+	TransactionStakeForfeited:                  "TransactionStakeForfeited",
 }
 
 func (t TransactionType) String() string {
@@ -81,6 +98,10 @@ var AllowedTransactionsForAddition = []TransactionType{
 	TransactionInterestUpvoteGiven,
 	TransactionRewardPayout,
 	TransactionCuratorReward,
+	TransactionDownvoteReturned,
+	TransactionDownvoterReward,
+	TransactionClaimCreationReturned,
+	TransactionInterestClaimCreation,
 }
 
 var AllowedTransactionsForEarning = []TransactionType{
@@ -104,6 +125,10 @@ var AllowedTransactionsForDeduction = []TransactionType{
 	TransactionInterestUpvoteGivenSlashed,
 	TransactionStakeCreatorSlashed,
 	TransactionStakeCuratorSlashed,
+	TransactionStakeWithdrawalPenalty,
+	TransactionDownvote,
+	TransactionClaimCreation,
+	TransactionStakeForfeited,
 }
 
 func (t TransactionType) AllowedForAddition() bool {
@@ -118,6 +118,43 @@ func (k Keeper) SubtractCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
 	return coins, nil
 }
 
+// ForfeitCoin moves a coin an address had put in a module account to another module account,
+// and adds the loss to the address' transactions.
+func (k Keeper) ForfeitCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
+	referenceID uint64, txType TransactionType, txSetters ...TransactionSetter) sdk.Error {
+	tx := Transaction{}
+	for _, setter := range txSetters {
+		setter(&tx)
+	}
+	if !txType.AllowedForDeduction() {
+		return ErrInvalidTransactionType(txType)
+	}
+	if tx.FromModuleAccount == "" || tx.ToModuleAccount == "" {
+		return sdk.ErrInternal("forfeiting coins requires a from and to module account")
+	}
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, tx.FromModuleAccount, tx.ToModuleAccount, sdk.Coins{amt})
+	if err != nil {
+		return err
+	}
+
+	transactionID, err := k.transactionID(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx.ID = transactionID
+	tx.Type = txType
+	tx.ReferenceID = referenceID
+	tx.Amount = amt
+	tx.AppAccountAddress = addr
+	tx.CreatedTime = ctx.BlockHeader().Time
+
+	k.setTransaction(ctx, tx)
+	k.setTransactionID(ctx, transactionID+1)
+	k.setUserTransaction(ctx, addr, tx.CreatedTime, tx.ID)
+	return nil
+}
+
 // SafeSubtractCoin subtracts a coin without going below zero
 func (k Keeper) SafeSubtractCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
 	referenceID uint64, txType TransactionType, txSetters ...TransactionSetter) (sdk.Coins, sdk.Coin, sdk.Error) {
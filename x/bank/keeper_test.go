@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	app "github.com/TruStory/truchain/types"
+	"github.com/TruStory/truchain/x/account"
 	"github.com/TruStory/truchain/x/bank/exported"
 )
 
@@ -149,6 +150,29 @@ func TestKeeper_SafeSubtractCoin(t *testing.T) {
 	})
 }
 
+func TestKeeper_ForfeitCoin(t *testing.T) {
+	ctx, k, _ := mockDB()
+	addr := sdk.AccAddress([]byte("cosmos123456789"))
+	amount := sdk.NewCoin(app.StakeDenom, sdk.NewInt(app.Shanev*20))
+
+	err := k.ForfeitCoin(ctx, addr, amount, 100, TransactionBackingReturned,
+		FromModuleAccount(account.UserGrowthPoolName), ToModuleAccount(account.UserGrowthPoolName))
+	assert.Error(t, err)
+	err = k.ForfeitCoin(ctx, addr, amount, 100, TransactionStakeForfeited,
+		FromModuleAccount(account.UserGrowthPoolName))
+	assert.Error(t, err)
+
+	err = k.ForfeitCoin(ctx, addr, amount, 100, TransactionStakeForfeited,
+		FromModuleAccount(account.UserGrowthPoolName), ToModuleAccount(account.UserGrowthPoolName))
+	assert.NoError(t, err)
+	txs := k.TransactionsByAddress(ctx, addr)
+	assert.Len(t, txs, 1)
+	assert.Equal(t, TransactionStakeForfeited, txs[0].Type)
+	assert.Equal(t, amount, txs[0].Amount)
+	assert.Equal(t, uint64(100), txs[0].ReferenceID)
+	assert.True(t, k.GetCoins(ctx, addr).Empty())
+}
+
 func TestKeeper_TransactionsByAddress(t *testing.T) {
 	ctx, k, auth := mockDB()
 
@@ -1 +1,26 @@
 package claim
+
+import (
+	"github.com/TruStory/truchain/x/bank/exported"
+	"github.com/TruStory/truchain/x/distribution"
+)
+
+// Aliased constants
+const (
+	TransactionClaimCreation         = exported.TransactionClaimCreation
+	TransactionClaimCreationReturned = exported.TransactionClaimCreationReturned
+	TransactionInterestClaimCreation = exported.TransactionInterestClaimCreation
+
+	UserRewardPoolName = distribution.UserRewardPoolName
+)
+
+type (
+	TransactionType = exported.TransactionType
+)
+
+// Transaction setters
+var (
+	WithCommunityID   = exported.WithCommunityID
+	FromModuleAccount = exported.FromModuleAccount
+	ToModuleAccount   = exported.ToModuleAccount
+)
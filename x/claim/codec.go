@@ -7,6 +7,8 @@ func RegisterCodec(c *codec.Codec) {
 	c.RegisterConcrete(MsgCreateClaim{}, "truchain/MsgCreateClaim", nil)
 	c.RegisterConcrete(MsgEditClaim{}, "truchain/MsgEditClaim", nil)
 	c.RegisterConcrete(MsgDeleteClaim{}, "truchain/MsgDeleteClaim", nil)
+	c.RegisterConcrete(MsgAddClaimSource{}, "truchain/MsgAddClaimSource", nil)
+	c.RegisterConcrete(MsgMergeClaims{}, "truchain/MsgMergeClaims", nil)
 	c.RegisterConcrete(MsgAddAdmin{}, "claim/MsgAddAdmin", nil)
 	c.RegisterConcrete(MsgRemoveAdmin{}, "claim/MsgRemoveAdmin", nil)
 	c.RegisterConcrete(MsgUpdateParams{}, "claim/MsgUpdateParams", nil)
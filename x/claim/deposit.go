@@ -0,0 +1,78 @@
+package claim
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// chargeCreationStake moves the creation stake of a new claim from its creator into the deposits pool
+func (k Keeper) chargeCreationStake(ctx sdk.Context, claim Claim) sdk.Error {
+	if !coinAmount(claim.CreationStake).IsPositive() {
+		return nil
+	}
+	_, err := k.bankKeeper.SubtractCoin(ctx, claim.Creator, claim.CreationStake, claim.ID,
+		TransactionClaimCreation, WithCommunityID(claim.CommunityID),
+		ToModuleAccount(UserClaimDepositsPoolName),
+	)
+	return err
+}
+
+// refundCreationStake returns the creation stake of a claim to its creator.
+// Claims that got argued on or resolved also earn interest on it from the user reward pool.
+// The refund is only committed if both the stake and the interest are paid out.
+func (k Keeper) refundCreationStake(ctx sdk.Context, claim Claim, withInterest bool) (Claim, sdk.Error) {
+	if claim.CreationStakeSettled || !coinAmount(claim.CreationStake).IsPositive() {
+		return claim, nil
+	}
+	cacheCtx, write := ctx.CacheContext()
+	_, err := k.bankKeeper.AddCoin(cacheCtx, claim.Creator, claim.CreationStake, claim.ID,
+		TransactionClaimCreationReturned, WithCommunityID(claim.CommunityID),
+		FromModuleAccount(UserClaimDepositsPoolName),
+	)
+	if err != nil {
+		return claim, err
+	}
+
+	if withInterest {
+		rate := k.GetParams(ctx).ClaimCreationInterestRate
+		interest := Interest(rate, claim.CreationStake, ctx.BlockHeader().Time.Sub(claim.CreatedTime)).TruncateInt()
+		if interest.IsPositive() {
+			_, err = k.bankKeeper.AddCoin(cacheCtx, claim.Creator, sdk.NewCoin(claim.CreationStake.Denom, interest), claim.ID,
+				TransactionInterestClaimCreation, WithCommunityID(claim.CommunityID),
+				FromModuleAccount(UserRewardPoolName),
+			)
+			if err != nil {
+				return claim, err
+			}
+		}
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	claim.CreationStakeSettled = true
+
+	return claim, nil
+}
+
+// forfeitCreationStake moves the creation stake of a spam claim to the user reward pool
+func (k Keeper) forfeitCreationStake(ctx sdk.Context, claim Claim) (Claim, sdk.Error) {
+	if claim.CreationStakeSettled || !coinAmount(claim.CreationStake).IsPositive() {
+		return claim, nil
+	}
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, UserClaimDepositsPoolName, UserRewardPoolName,
+		sdk.NewCoins(claim.CreationStake))
+	if err != nil {
+		return claim, err
+	}
+	claim.CreationStakeSettled = true
+
+	return claim, nil
+}
+
+// Interest calculates the interest of an amount held for a period at a yearly rate
+func Interest(interestRate sdk.Dec, amount sdk.Coin, period time.Duration) sdk.Dec {
+	periodDec := sdk.NewDec(period.Nanoseconds())
+	amountDec := sdk.NewDecFromInt(amount.Amount)
+	oneYearDec := sdk.NewDec((time.Hour * 24 * 365).Nanoseconds())
+	return interestRate.Mul(periodDec.Quo(oneYearDec)).Mul(amountDec)
+}
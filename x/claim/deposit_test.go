@@ -0,0 +1,129 @@
+package claim
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	app "github.com/TruStory/truchain/types"
+	trubank "github.com/TruStory/truchain/x/bank"
+)
+
+func balance(ctx sdk.Context, keeper Keeper, addr sdk.AccAddress) sdk.Int {
+	return keeper.bankKeeper.(trubank.Keeper).GetCoins(ctx, addr).AmountOf(app.StakeDenom)
+}
+
+func poolBalance(ctx sdk.Context, keeper Keeper, name string) sdk.Int {
+	return keeper.supplyKeeper.GetModuleAccount(ctx, name).GetCoins().AmountOf(app.StakeDenom)
+}
+
+func TestKeeper_CreationStake(t *testing.T) {
+	ctx, keeper := mockDB()
+	ctx = ctx.WithBlockTime(time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC))
+	params := keeper.GetParams(ctx)
+	params.ClaimCreationInterestRate = sdk.NewDecWithPrec(365, 2)
+	keeper.SetParams(ctx, params)
+	stake := params.ClaimCreationStake.Amount
+	funded := sdk.NewInt(app.Shanev * 100)
+
+	claim := fakeClaim(ctx, keeper, "crypto")
+	assert.Equal(t, params.ClaimCreationStake, claim.CreationStake)
+	assert.False(t, claim.CreationStakeSettled)
+	assert.Equal(t, funded.Sub(stake), balance(ctx, keeper, claim.Creator))
+	assert.Equal(t, stake, poolBalance(ctx, keeper, UserClaimDepositsPoolName))
+
+	// the first argument refunds the stake with a day of interest
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Hour * 24))
+	err := keeper.SetFirstArgumentTime(ctx, claim.ID, ctx.BlockHeader().Time)
+	assert.NoError(t, err)
+	claim, _ = keeper.Claim(ctx, claim.ID)
+	assert.True(t, claim.CreationStakeSettled)
+	interest := Interest(params.ClaimCreationInterestRate, params.ClaimCreationStake, time.Hour*24).TruncateInt()
+	assert.True(t, interest.IsPositive())
+	assert.Equal(t, funded.Add(interest), balance(ctx, keeper, claim.Creator))
+	assert.True(t, poolBalance(ctx, keeper, UserClaimDepositsPoolName).IsZero())
+
+	// further arguments don't pay twice
+	err = keeper.SetFirstArgumentTime(ctx, claim.ID, ctx.BlockHeader().Time)
+	assert.NoError(t, err)
+	assert.Equal(t, funded.Add(interest), balance(ctx, keeper, claim.Creator))
+
+	broke := getFakeAdmin()
+	_, err = keeper.SubmitClaim(ctx, "body string ajsdkhfakjsdfhd", "crypto", broke, url.URL{})
+	assert.Error(t, err)
+	assert.Len(t, keeper.CreatorClaims(ctx, broke), 0)
+}
+
+func TestKeeper_CreationStakeFailedInterest(t *testing.T) {
+	ctx, keeper := mockDB()
+	ctx = ctx.WithBlockTime(time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC))
+	params := keeper.GetParams(ctx)
+	params.ClaimCreationInterestRate = sdk.NewDecWithPrec(365, 2)
+	keeper.SetParams(ctx, params)
+	stake := params.ClaimCreationStake.Amount
+	funded := sdk.NewInt(app.Shanev * 100)
+	claim := fakeClaim(ctx, keeper, "crypto")
+
+	// an empty reward pool can't pay the interest
+	rewardPool := keeper.supplyKeeper.GetModuleAccount(ctx, UserRewardPoolName)
+	err := rewardPool.SetCoins(sdk.Coins{})
+	assert.NoError(t, err)
+	keeper.supplyKeeper.SetModuleAccount(ctx, rewardPool)
+
+	// the deposit isn't paid back without the interest
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Hour * 24))
+	keeper.resolveClaim(ctx, claim)
+	claim, _ = keeper.Claim(ctx, claim.ID)
+	assert.Equal(t, StatusResolved, claim.Status)
+	assert.False(t, claim.CreationStakeSettled)
+	assert.Equal(t, funded.Sub(stake), balance(ctx, keeper, claim.Creator))
+	assert.Equal(t, stake, poolBalance(ctx, keeper, UserClaimDepositsPoolName))
+}
+
+func TestKeeper_CreationStakeRemovedClaims(t *testing.T) {
+	ctx, keeper := mockDB()
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	stake := keeper.GetParams(ctx).ClaimCreationStake.Amount
+	funded := sdk.NewInt(app.Shanev * 100)
+	rewardPool := poolBalance(ctx, keeper, UserRewardPoolName)
+
+	deleted := fakeClaim(ctx, keeper, "crypto")
+	_, err := keeper.DeleteClaim(ctx, deleted.ID, deleted.Creator, true)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+	deleted, err = keeper.DeleteClaim(ctx, deleted.ID, deleted.Creator, false)
+	assert.NoError(t, err)
+	assert.True(t, deleted.CreationStakeSettled)
+	assert.Equal(t, funded, balance(ctx, keeper, deleted.Creator))
+
+	archived := fakeClaim(ctx, keeper, "crypto")
+	archived, err = keeper.DeleteClaim(ctx, archived.ID, admin, false)
+	assert.NoError(t, err)
+	assert.False(t, archived.Spam)
+	assert.Equal(t, funded, balance(ctx, keeper, archived.Creator))
+
+	spam := fakeClaim(ctx, keeper, "crypto")
+	spam, err = keeper.DeleteClaim(ctx, spam.ID, admin, true)
+	assert.NoError(t, err)
+	assert.True(t, spam.Spam)
+	assert.True(t, spam.CreationStakeSettled)
+	assert.Equal(t, funded.Sub(stake), balance(ctx, keeper, spam.Creator))
+	assert.Equal(t, rewardPool.Add(stake), poolBalance(ctx, keeper, UserRewardPoolName))
+	assert.True(t, poolBalance(ctx, keeper, UserClaimDepositsPoolName).IsZero())
+}
+
+func TestInitGenesis_CreationStakes(t *testing.T) {
+	ctx, keeper := mockDB()
+	genesis := DefaultGenesisState()
+	stake := genesis.Params.ClaimCreationStake
+	genesis.Claims = []Claim{
+		{ID: 1, CommunityID: "crypto", Creator: getFakeAdmin(), CreationStake: stake},
+		{ID: 2, CommunityID: "crypto", Creator: getFakeAdmin(), CreationStake: stake, CreationStakeSettled: true},
+		{ID: 3, CommunityID: "crypto", Creator: getFakeAdmin()},
+	}
+	InitGenesis(ctx, keeper, genesis)
+	assert.Equal(t, stake.Amount, poolBalance(ctx, keeper, UserClaimDepositsPoolName))
+}
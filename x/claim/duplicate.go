@@ -0,0 +1,110 @@
+package claim
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"unicode"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// normalizeBody lowercases a claim body, strips its punctuation and collapses its whitespace,
+// so that claims only differing in formatting are seen as duplicates
+func normalizeBody(body string) string {
+	stripped := strings.Map(func(r rune) rune {
+		if unicode.IsPunct(r) {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, body)
+
+	return strings.Join(strings.Fields(stripped), " ")
+}
+
+// bodyHash is the hash of the normalized body of a claim
+func bodyHash(body string) []byte {
+	hash := sha256.Sum256([]byte(normalizeBody(body)))
+	return hash[:]
+}
+
+// SimilarClaims gets the claims of a community with the same normalized body
+func (k Keeper) SimilarClaims(ctx sdk.Context, communityID, body string) (claims Claims) {
+	return k.associatedClaims(ctx, bodyHashClaimsKey(communityID, bodyHash(body)))
+}
+
+// duplicateClaim gets a claim of a community with the same normalized body, if there is one
+func (k Keeper) duplicateClaim(ctx sdk.Context, communityID, body string) (Claim, bool) {
+	claims := k.SimilarClaims(ctx, communityID, body)
+	if len(claims) == 0 {
+		return Claim{}, false
+	}
+	// associated claims are listed newest first
+	return claims[len(claims)-1], true
+}
+
+// MergeClaims lets admins fold a duplicate claim into the canonical claim of a community.
+// The claim hooks move the arguments of the duplicate, which is then archived.
+func (k Keeper) MergeClaims(ctx sdk.Context, duplicateID, canonicalID uint64, admin sdk.AccAddress) (claim Claim, err sdk.Error) {
+	if !k.isAdmin(ctx, admin) {
+		return claim, ErrAddressNotAuthorised()
+	}
+	if duplicateID == canonicalID {
+		return claim, ErrInvalidClaimMerge("a claim can't be merged into itself")
+	}
+	duplicate, ok := k.Claim(ctx, duplicateID)
+	if !ok {
+		return claim, ErrUnknownClaim(duplicateID)
+	}
+	if duplicate.Removed() {
+		return claim, ErrClaimRemoved(duplicateID)
+	}
+	canonical, ok := k.Claim(ctx, canonicalID)
+	if !ok {
+		return claim, ErrUnknownClaim(canonicalID)
+	}
+	if canonical.Removed() {
+		return claim, ErrClaimRemoved(canonicalID)
+	}
+	if duplicate.CommunityID != canonical.CommunityID {
+		return claim, ErrInvalidClaimMerge("claims of different communities can't be merged")
+	}
+	if !canonical.AcceptsArguments() {
+		return claim, ErrInvalidClaimMerge(fmt.Sprintf("claim %d doesn't accept arguments", canonicalID))
+	}
+
+	err = k.afterClaimsMerged(ctx, duplicate, canonical)
+	if err != nil {
+		return claim, err
+	}
+
+	duplicate, err = k.DeleteClaim(ctx, duplicateID, admin, false)
+	if err != nil {
+		return claim, err
+	}
+	duplicate.MergedInto = canonicalID
+	k.setClaim(ctx, duplicate)
+	logger(ctx).Info(fmt.Sprintf("Merged claim %d into claim %d", duplicateID, canonicalID))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeClaimsMerged,
+			sdk.NewAttribute(AttributeKeyClaimID, fmt.Sprintf("%d", canonicalID)),
+			sdk.NewAttribute(AttributeKeyMergedClaimID, fmt.Sprintf("%d", duplicateID)),
+		),
+	)
+
+	// the hooks updated the stake totals of the canonical claim
+	claim, _ = k.Claim(ctx, canonicalID)
+	return claim, nil
+}
+
+func (k Keeper) setBodyHashClaim(ctx sdk.Context, communityID, body string, claimID uint64) {
+	store := k.store(ctx)
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(claimID)
+	store.Set(bodyHashClaimKey(communityID, bodyHash(body), claimID), bz)
+}
+
+func (k Keeper) deleteBodyHashClaim(ctx sdk.Context, communityID, body string, claimID uint64) {
+	k.store(ctx).Delete(bodyHashClaimKey(communityID, bodyHash(body), claimID))
+}
@@ -0,0 +1,144 @@
+package claim
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestNormalizeBody(t *testing.T) {
+	assert.Equal(t, "bitcoin is a store of value", normalizeBody("  Bitcoin is a store\tof\n value!!"))
+	assert.Equal(t, "its a claim", normalizeBody("It's a... claim?"))
+	assert.Equal(t, bodyHash("Bitcoin is a store of value."), bodyHash("bitcoin IS a store of   value"))
+	assert.NotEqual(t, bodyHash("Bitcoin is a store of value"), bodyHash("Bitcoin is not a store of value"))
+}
+
+func TestSubmitClaim_Duplicate(t *testing.T) {
+	ctx, keeper := mockDB()
+	creator := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	fundAccount(ctx, keeper, creator)
+	submit := func(communityID, body string) (Claim, sdk.Error) {
+		return keeper.SubmitClaim(ctx, body, communityID, creator, url.URL{})
+	}
+
+	original, err := submit("crypto", "Bitcoin is a store of value.")
+	assert.NoError(t, err)
+
+	_, err = submit("crypto", "  bitcoin IS a store of value!")
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeDuplicateClaim, err.Code())
+	assert.Equal(t, ErrDuplicateClaim(original.ID).Error(), err.Error())
+
+	// another community can have the same claim
+	_, err = submit("meme", "Bitcoin is a store of value.")
+	assert.NoError(t, err)
+
+	// edits move the claim in the index
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	_, err = keeper.EditClaim(ctx, original.ID, "Bitcoin is digital gold for the internet age", admin)
+	assert.NoError(t, err)
+	_, err = submit("crypto", "Bitcoin is digital gold, for the internet age!")
+	assert.Equal(t, ErrorCodeDuplicateClaim, err.Code())
+	resubmitted, err := submit("crypto", "Bitcoin is a store of value.")
+	assert.NoError(t, err)
+
+	// edits can't turn a claim into a duplicate, but a claim can be reworded
+	_, err = keeper.EditClaim(ctx, resubmitted.ID, "bitcoin is digital gold for the internet age", admin)
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrDuplicateClaim(original.ID).Error(), err.Error())
+	_, err = keeper.EditClaim(ctx, original.ID, "Bitcoin is digital gold, for the internet age.", admin)
+	assert.NoError(t, err)
+
+	// removed claims don't block new ones
+	_, err = keeper.DeleteClaim(ctx, resubmitted.ID, admin, false)
+	assert.NoError(t, err)
+	_, err = submit("crypto", "Bitcoin is a store of value.")
+	assert.NoError(t, err)
+}
+
+func TestQuerySimilarClaims(t *testing.T) {
+	ctx, keeper := mockDB()
+	querier := NewQuerier(keeper)
+	claim := fakeClaim(ctx, keeper, "crypto")
+	fakeClaim(ctx, keeper, "crypto")
+
+	query := func(communityID, body string) Claims {
+		resBytes, err := querier(ctx, []string{QuerySimilarClaims}, abci.RequestQuery{
+			Path: strings.Join([]string{custom, QuerySimilarClaims}, "/"),
+			Data: ModuleCodec.MustMarshalJSON(QuerySimilarClaimsParams{CommunityID: communityID, Body: body}),
+		})
+		assert.NoError(t, err)
+		var claims Claims
+		ModuleCodec.MustUnmarshalJSON(resBytes, &claims)
+		return claims
+	}
+
+	claims := query("crypto", strings.ToUpper(claim.Body)+"?")
+	assert.Len(t, claims, 1)
+	assert.Equal(t, claim.ID, claims[0].ID)
+	assert.Len(t, query("meme", claim.Body), 0)
+	assert.Len(t, query("crypto", "something else entirely"), 0)
+}
+
+func TestMergeClaims(t *testing.T) {
+	ctx, keeper := mockDB()
+	hooks := &recordingClaimHooks{}
+	keeper.SetHooks(hooks)
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+
+	canonical := fakeClaim(ctx, keeper, "crypto")
+	duplicate := fakeClaim(ctx, keeper, "crypto")
+	other := fakeClaim(ctx, keeper, "meme")
+
+	_, err := keeper.MergeClaims(ctx, duplicate.ID, canonical.ID, duplicate.Creator)
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+	_, err = keeper.MergeClaims(ctx, duplicate.ID, duplicate.ID, admin)
+	assert.Equal(t, ErrorCodeInvalidClaimMerge, err.Code())
+	_, err = keeper.MergeClaims(ctx, other.ID, canonical.ID, admin)
+	assert.Equal(t, ErrorCodeInvalidClaimMerge, err.Code())
+	_, err = keeper.MergeClaims(ctx, 99, canonical.ID, admin)
+	assert.Equal(t, ErrorCodeInvalidID, err.Code())
+	assert.Len(t, hooks.mergedClaims, 0)
+
+	claim, err := keeper.MergeClaims(ctx, duplicate.ID, canonical.ID, admin)
+	assert.NoError(t, err)
+	assert.Equal(t, canonical.ID, claim.ID)
+	assert.Equal(t, [][2]uint64{{duplicate.ID, canonical.ID}}, hooks.mergedClaims)
+	assert.Equal(t, []uint64{duplicate.ID}, hooks.removedClaims)
+
+	merged, ok := keeper.Claim(ctx, duplicate.ID)
+	assert.True(t, ok)
+	assert.True(t, merged.Archived)
+	assert.Equal(t, canonical.ID, merged.MergedInto)
+	assert.Len(t, keeper.CommunityClaims(ctx, "crypto"), 1)
+
+	_, err = keeper.MergeClaims(ctx, duplicate.ID, canonical.ID, admin)
+	assert.Equal(t, ErrorCodeClaimRemoved, err.Code())
+}
+
+func TestMsgMergeClaims(t *testing.T) {
+	ctx, keeper := mockDB()
+	handler := NewHandler(keeper)
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	canonical := fakeClaim(ctx, keeper, "crypto")
+	duplicate := fakeClaim(ctx, keeper, "crypto")
+
+	msg := NewMsgMergeClaims(duplicate.ID, canonical.ID, admin)
+	assert.Nil(t, msg.ValidateBasic())
+	assert.Equal(t, ModuleName, msg.Route())
+	assert.Equal(t, TypeMsgMergeClaims, msg.Type())
+	assert.Equal(t, ErrorCodeInvalidClaimMerge, NewMsgMergeClaims(1, 1, admin).ValidateBasic().Code())
+	assert.Equal(t, ErrorCodeInvalidID, NewMsgMergeClaims(0, 1, admin).ValidateBasic().Code())
+	assert.Equal(t, sdk.ErrInvalidAddress("").Code(), NewMsgMergeClaims(2, 1, nil).ValidateBasic().Code())
+
+	res := handler(ctx, msg)
+	assert.True(t, res.IsOK())
+	var claim Claim
+	ModuleCodec.MustUnmarshalJSON(res.Data, &claim)
+	assert.Equal(t, canonical.ID, claim.ID)
+}
@@ -23,6 +23,14 @@ const (
 	ErrorCodeCreatorJailed               CodeType = 108
 	ErrorCodeAddressNotAuthorised        CodeType = 109
 	ErrorCodeJSONParsing                 CodeType = 110
+	ErrorCodeInvalidClaimStatus          CodeType = 111
+	ErrorCodeClaimHasArguments           CodeType = 112
+	ErrorCodeClaimRemoved                CodeType = 113
+	ErrorCodeTooManySources              CodeType = 114
+	ErrorCodeDuplicateSource             CodeType = 115
+	ErrorCodeInvalidSourceKind           CodeType = 116
+	ErrorCodeDuplicateClaim              CodeType = 117
+	ErrorCodeInvalidClaimMerge           CodeType = 118
 )
 
 // ErrInvalidBodyTooShort throws an error on invalid claim body
@@ -88,3 +96,67 @@ func ErrJSONParse(err error) sdk.Error {
 		ErrorCodeJSONParsing,
 		"JSON parsing error: "+err.Error())
 }
+
+// ErrInvalidClaimStatus throws an error on an unknown claim status
+func ErrInvalidClaimStatus(status ClaimStatus) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeInvalidClaimStatus,
+		fmt.Sprintf("Invalid claim status: %d", status))
+}
+
+// ErrClaimHasArguments throws an error when a creator deletes a claim that was argued on
+func ErrClaimHasArguments(id uint64) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeClaimHasArguments,
+		fmt.Sprintf("Claim %d already has arguments", id))
+}
+
+// ErrClaimRemoved throws an error on a claim that was archived or deleted
+func ErrClaimRemoved(id uint64) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeClaimRemoved,
+		fmt.Sprintf("Claim %d has been removed", id))
+}
+
+// ErrTooManySources throws an error when a claim already has the maximum number of sources
+func ErrTooManySources(id uint64) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeTooManySources,
+		fmt.Sprintf("Claim %d has the maximum number of sources", id))
+}
+
+// ErrDuplicateSource throws an error when a source is added to a claim twice
+func ErrDuplicateSource(url string) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeDuplicateSource,
+		"Duplicate source URL: "+url)
+}
+
+// ErrInvalidSourceKind throws an error on an unknown source kind
+func ErrInvalidSourceKind(kind SourceKind) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeInvalidSourceKind,
+		fmt.Sprintf("Invalid source kind: %d", kind))
+}
+
+// ErrDuplicateClaim throws an error when a community already has a claim with the same body
+func ErrDuplicateClaim(existingID uint64) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeDuplicateClaim,
+		fmt.Sprintf("Duplicate of claim %d", existingID))
+}
+
+// ErrInvalidClaimMerge throws an error when two claims can't be merged
+func ErrInvalidClaimMerge(reason string) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeInvalidClaimMerge,
+		"Invalid claim merge: "+reason)
+}
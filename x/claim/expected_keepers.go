@@ -1,6 +1,7 @@
 package claim
 
 import (
+	bankexported "github.com/TruStory/truchain/x/bank/exported"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -8,3 +9,11 @@ import (
 type AccountKeeper interface {
 	IsJailed(ctx sdk.Context, addr sdk.AccAddress) (bool, sdk.Error)
 }
+
+// BankKeeper is the expected bank keeper interface for this module
+type BankKeeper interface {
+	AddCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
+		referenceID uint64, txType TransactionType, setters ...bankexported.TransactionSetter) (sdk.Coins, sdk.Error)
+	SubtractCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
+		referenceID uint64, txType TransactionType, setters ...bankexported.TransactionSetter) (sdk.Coins, sdk.Error)
+}
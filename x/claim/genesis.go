@@ -3,6 +3,7 @@ package claim
 import (
 	"fmt"
 
+	app "github.com/TruStory/truchain/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,6 +11,8 @@ import (
 type GenesisState struct {
 	Claims []Claim `json:"claims"`
 	Params Params  `json:"params"`
+	// NextClaimID defaults to the highest imported claim id + 1 when unset
+	NextClaimID uint64 `json:"next_claim_id"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -25,21 +28,68 @@ func DefaultGenesisState() GenesisState { return NewGenesisState() }
 
 // InitGenesis initializes story state from genesis file
 func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	mintDepositsPool := k.supplyKeeper.GetModuleAccount(ctx, UserClaimDepositsPoolName).GetCoins().Empty()
 	for _, c := range data.Claims {
+		// the deposits pool holds the creation stakes that weren't settled yet
+		if mintDepositsPool && !c.CreationStakeSettled && coinAmount(c.CreationStake).IsPositive() {
+			err := k.supplyKeeper.MintCoins(ctx, UserClaimDepositsPoolName, sdk.NewCoins(c.CreationStake))
+			if err != nil {
+				panic(err)
+			}
+		}
+		// claims created before the lifecycle existed get their times from the params
+		if c.LockTime.IsZero() {
+			c.LockTime = c.CreatedTime.Add(data.Params.OpenPeriod)
+		}
+		if c.ResolveTime.IsZero() {
+			c.ResolveTime = c.LockTime.Add(data.Params.LockedPeriod)
+		}
+		c = migrateLegacySource(c)
 		k.setClaim(ctx, c)
+		// removed claims are only kept as tombstones
+		if c.Removed() {
+			continue
+		}
 		k.setCommunityClaim(ctx, c.CommunityID, c.ID)
 		k.setCreatorClaim(ctx, c.Creator, c.ID)
 		k.setCreatedTimeClaim(ctx, c.CreatedTime, c.ID)
+		k.setStatusClaim(ctx, c.Status, c.ID)
+		k.setDomainClaims(ctx, c)
+		k.setBodyHashClaim(ctx, c.CommunityID, c.Body, c.ID)
+		if c.Status != StatusResolved {
+			k.insertClaimQueue(ctx, c.nextTransitionTime(), c.ID)
+		}
 	}
-	k.setClaimID(ctx, uint64(len(data.Claims)+1))
+	maxID := maxClaimID(data.Claims)
+	nextID := data.NextClaimID
+	if nextID == 0 {
+		nextID = maxID + 1
+	}
+	if nextID <= maxID {
+		panic(fmt.Sprintf("next claim id %d must be greater than the highest claim id %d", nextID, maxID))
+	}
+	k.setClaimID(ctx, nextID)
 	k.SetParams(ctx, data.Params)
 }
 
+func maxClaimID(claims []Claim) uint64 {
+	maxID := uint64(0)
+	for _, c := range claims {
+		if c.ID > maxID {
+			maxID = c.ID
+		}
+	}
+	return maxID
+}
+
 // ExportGenesis exports the genesis state
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	// an unset id is exported as 0 and defaults on import
+	claimID, _ := k.claimID(ctx)
 	return GenesisState{
-		Claims: k.Claims(ctx),
-		Params: k.GetParams(ctx),
+		Claims:      k.Claims(ctx),
+		Params:      k.GetParams(ctx),
+		NextClaimID: claimID,
 	}
 }
 
@@ -52,5 +102,41 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("Param: MaxClaimLength must have a positive value")
 	}
 
+	if data.Params.OpenPeriod <= 0 {
+		return fmt.Errorf("Param: OpenPeriod must have a positive value")
+	}
+	if data.Params.LockedPeriod <= 0 {
+		return fmt.Errorf("Param: LockedPeriod must have a positive value")
+	}
+	if data.Params.Quorum.Denom != app.StakeDenom || data.Params.Quorum.IsNegative() {
+		return fmt.Errorf("Param: Quorum must be a non negative amount of %s", app.StakeDenom)
+	}
+	if data.Params.ClaimCreationStake.Denom != app.StakeDenom || data.Params.ClaimCreationStake.IsNegative() {
+		return fmt.Errorf("Param: ClaimCreationStake must be a non negative amount of %s", app.StakeDenom)
+	}
+	if data.Params.ClaimCreationInterestRate.IsNegative() {
+		return fmt.Errorf("Param: ClaimCreationInterestRate must not be negative")
+	}
+	if data.Params.MaxClaimSources < 1 {
+		return fmt.Errorf("Param: MaxClaimSources must have a positive value")
+	}
+
+	ids := make(map[uint64]bool)
+	for _, c := range data.Claims {
+		if ids[c.ID] {
+			return fmt.Errorf("Claim: duplicate claim id %d", c.ID)
+		}
+		ids[c.ID] = true
+		if !c.Status.Valid() {
+			return fmt.Errorf("Claim: %d has an invalid status", c.ID)
+		}
+		if (c.Status == StatusResolved) != (c.Verdict != VerdictNone) {
+			return fmt.Errorf("Claim: %d must have a verdict once resolved, and only then", c.ID)
+		}
+	}
+	if data.NextClaimID != 0 && data.NextClaimID <= maxClaimID(data.Claims) {
+		return fmt.Errorf("NextClaimID: %d must be greater than every claim id", data.NextClaimID)
+	}
+
 	return nil
 }
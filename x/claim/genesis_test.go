@@ -0,0 +1,48 @@
+package claim
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	app "github.com/TruStory/truchain/types"
+)
+
+func TestInitGenesis_NextClaimID(t *testing.T) {
+	ctx, keeper := mockDB()
+	claim := fakeClaim(ctx, keeper, "crypto")
+	claim.ID = 7
+	genesis := DefaultGenesisState()
+	genesis.Claims = []Claim{claim}
+	InitGenesis(ctx, keeper, genesis)
+
+	// the next id follows the highest id rather than the number of claims
+	assert.Equal(t, uint64(8), ExportGenesis(ctx, keeper).NextClaimID)
+	next := fakeClaim(ctx, keeper, "crypto")
+	assert.Equal(t, uint64(8), next.ID)
+
+	genesis.NextClaimID = 7
+	assert.Panics(t, func() { InitGenesis(ctx, keeper, genesis) })
+}
+
+func TestValidateGenesis(t *testing.T) {
+	genesis := DefaultGenesisState()
+	genesis.Claims = []Claim{{ID: 1}, {ID: 4}}
+	assert.NoError(t, ValidateGenesis(genesis))
+
+	genesis.NextClaimID = 4
+	assert.Error(t, ValidateGenesis(genesis))
+	genesis.NextClaimID = 5
+	assert.NoError(t, ValidateGenesis(genesis))
+
+	genesis.Claims = append(genesis.Claims, Claim{ID: 1})
+	assert.Error(t, ValidateGenesis(genesis))
+	genesis.Claims = nil
+
+	genesis.Params.ClaimCreationStake.Denom = "my-denom"
+	assert.Error(t, ValidateGenesis(genesis))
+	genesis.Params.ClaimCreationStake.Denom = app.StakeDenom
+	genesis.Params.ClaimCreationInterestRate = sdk.NewDec(-1)
+	assert.Error(t, ValidateGenesis(genesis))
+}
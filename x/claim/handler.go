@@ -15,6 +15,12 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgCreateClaim(ctx, keeper, msg)
 		case MsgEditClaim:
 			return handleMsgEditClaim(ctx, keeper, msg)
+		case MsgDeleteClaim:
+			return handleMsgDeleteClaim(ctx, keeper, msg)
+		case MsgAddClaimSource:
+			return handleMsgAddClaimSource(ctx, keeper, msg)
+		case MsgMergeClaims:
+			return handleMsgMergeClaims(ctx, keeper, msg)
 		case MsgAddAdmin:
 			return handleMsgAddAdmin(ctx, keeper, msg)
 		case MsgRemoveAdmin:
@@ -74,6 +80,71 @@ func handleMsgEditClaim(ctx sdk.Context, keeper Keeper, msg MsgEditClaim) sdk.Re
 	}
 }
 
+func handleMsgDeleteClaim(ctx sdk.Context, keeper Keeper, msg MsgDeleteClaim) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	claim, err := keeper.DeleteClaim(ctx, msg.ID, msg.Creator, msg.Spam)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, codecErr := ModuleCodec.MarshalJSON(claim)
+	if codecErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgAddClaimSource(ctx sdk.Context, keeper Keeper, msg MsgAddClaimSource) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	sourceURL, err := parseSourceURL(msg.URL)
+	if err != nil {
+		return err.Result()
+	}
+
+	claim, err := keeper.AddClaimSource(ctx, msg.ID, *sourceURL, msg.Kind, msg.Title, msg.Creator)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, codecErr := ModuleCodec.MarshalJSON(claim)
+	if codecErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgMergeClaims(ctx sdk.Context, keeper Keeper, msg MsgMergeClaims) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	claim, err := keeper.MergeClaims(ctx, msg.DuplicateID, msg.CanonicalID, msg.Admin)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, codecErr := ModuleCodec.MarshalJSON(claim)
+	if codecErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
 func handleMsgAddAdmin(ctx sdk.Context, k Keeper, msg MsgAddAdmin) sdk.Result {
 	if err := msg.ValidateBasic(); err != nil {
 		return err.Result()
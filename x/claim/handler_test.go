@@ -18,6 +18,7 @@ func TestMsgCreateClaim(t *testing.T) {
 	body := "fake story body with minimum length"
 	creator := sdk.AccAddress([]byte{1, 2})
 	source := "http://trustory.io"
+	fundAccount(ctx, keeper, creator)
 	msg := NewMsgCreateClaim(communityID, body, creator, source)
 	assert.NotNil(t, msg)
 
@@ -87,3 +88,25 @@ func TestMsgEditClaim(t *testing.T) {
 	assert.Equal(t, updated.ID, claim.ID)
 	assert.Equal(t, updated.Body, updatedBody)
 }
+
+func TestMsgDeleteClaim(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	handler := NewHandler(keeper)
+	claim := fakeClaim(ctx, keeper, "crypto")
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+
+	msg := NewMsgDeleteClaim(claim.ID, admin, false)
+	assert.Equal(t, TypeMsgDeleteClaim, msg.Type())
+
+	res := handler(ctx, msg)
+	assert.True(t, res.IsOK())
+
+	var archived Claim
+	assert.NoError(t, ModuleCodec.UnmarshalJSON(res.Data, &archived))
+	assert.Equal(t, claim.ID, archived.ID)
+	assert.True(t, archived.Archived)
+
+	res = handler(ctx, NewMsgDeleteClaim(0, admin, false))
+	assert.False(t, res.IsOK())
+}
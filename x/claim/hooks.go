@@ -0,0 +1,67 @@
+package claim
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ClaimHooks lets other modules react to the lifecycle of claims.
+// A removed claim is either archived by an admin or deleted by its creator.
+// A duplicate claim merged into another one is archived right after the merge.
+// An error returned by a hook fails the transaction removing or merging the claims.
+type ClaimHooks interface {
+	AfterClaimRemoved(ctx sdk.Context, claim Claim) sdk.Error
+	AfterClaimsMerged(ctx sdk.Context, duplicate, canonical Claim) sdk.Error
+}
+
+// MultiClaimHooks combines multiple claim hooks, all hook functions are run in array sequence
+type MultiClaimHooks []ClaimHooks
+
+var _ ClaimHooks = MultiClaimHooks{}
+
+// NewMultiClaimHooks combines claim hooks
+func NewMultiClaimHooks(hooks ...ClaimHooks) MultiClaimHooks {
+	return hooks
+}
+
+// AfterClaimRemoved implements ClaimHooks
+func (h MultiClaimHooks) AfterClaimRemoved(ctx sdk.Context, claim Claim) sdk.Error {
+	for i := range h {
+		if err := h[i].AfterClaimRemoved(ctx, claim); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterClaimsMerged implements ClaimHooks
+func (h MultiClaimHooks) AfterClaimsMerged(ctx sdk.Context, duplicate, canonical Claim) sdk.Error {
+	for i := range h {
+		if err := h[i].AfterClaimsMerged(ctx, duplicate, canonical); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetHooks sets the claim hooks, they can only be set once
+func (k *Keeper) SetHooks(ch ClaimHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set claim hooks twice")
+	}
+	k.hooks = ch
+	return k
+}
+
+func (k Keeper) afterClaimRemoved(ctx sdk.Context, claim Claim) sdk.Error {
+	if k.hooks != nil {
+		return k.hooks.AfterClaimRemoved(ctx, claim)
+	}
+	return nil
+}
+
+func (k Keeper) afterClaimsMerged(ctx sdk.Context, duplicate, canonical Claim) sdk.Error {
+	if k.hooks != nil {
+		return k.hooks.AfterClaimsMerged(ctx, duplicate, canonical)
+	}
+	return nil
+}
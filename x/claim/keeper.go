@@ -1,6 +1,7 @@
 package claim
 
 import (
+	"fmt"
 	"net/url"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/gaskv"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	log "github.com/tendermint/tendermint/libs/log"
 )
 
@@ -20,17 +22,23 @@ type Keeper struct {
 	paramStore params.Subspace
 
 	accountKeeper   AccountKeeper
+	bankKeeper      BankKeeper
+	supplyKeeper    supply.Keeper
 	communityKeeper community.Keeper
+	hooks           ClaimHooks
 }
 
 // NewKeeper creates a new claim keeper
-func NewKeeper(storeKey sdk.StoreKey, paramStore params.Subspace, codec *codec.Codec, accountKeeper AccountKeeper, communityKeeper community.Keeper) Keeper {
+func NewKeeper(storeKey sdk.StoreKey, paramStore params.Subspace, codec *codec.Codec,
+	accountKeeper AccountKeeper, bankKeeper BankKeeper, supplyKeeper supply.Keeper, communityKeeper community.Keeper) Keeper {
 	return Keeper{
-		storeKey,
-		codec,
-		paramStore.WithKeyTable(ParamKeyTable()),
-		accountKeeper,
-		communityKeeper,
+		storeKey:        storeKey,
+		codec:           codec,
+		paramStore:      paramStore.WithKeyTable(ParamKeyTable()),
+		accountKeeper:   accountKeeper,
+		bankKeeper:      bankKeeper,
+		supplyKeeper:    supplyKeeper,
+		communityKeeper: communityKeeper,
 	}
 }
 
@@ -53,6 +61,9 @@ func (k Keeper) SubmitClaim(ctx sdk.Context, body, communityID string,
 	if err != nil {
 		return claim, ErrInvalidCommunityID(community.ID)
 	}
+	if existing, ok := k.duplicateClaim(ctx, communityID, body); ok {
+		return claim, ErrDuplicateClaim(existing.ID)
+	}
 
 	claimID, err := k.claimID(ctx)
 	if err != nil {
@@ -61,6 +72,22 @@ func (k Keeper) SubmitClaim(ctx sdk.Context, body, communityID string,
 	claim = NewClaim(claimID, communityID, body, creator, source,
 		ctx.BlockHeader().Time,
 	)
+	params := k.GetParams(ctx)
+	claim.LockTime = claim.CreatedTime.Add(params.OpenPeriod)
+	claim.ResolveTime = claim.LockTime.Add(params.LockedPeriod)
+	claim.CreationStake = params.ClaimCreationStake
+	// the source given on creation is the first of the claim's sources
+	if source.String() != "" {
+		if _, err = parseSourceURL(source.String()); err != nil {
+			return Claim{}, err
+		}
+		claim.Sources = []ClaimSource{{URL: source, Kind: SourceArticle, AddedBy: creator, AddedTime: claim.CreatedTime}}
+	}
+
+	err = k.chargeCreationStake(ctx, claim)
+	if err != nil {
+		return Claim{}, err
+	}
 
 	// persist claim
 	k.setClaim(ctx, claim)
@@ -71,6 +98,10 @@ func (k Keeper) SubmitClaim(ctx sdk.Context, body, communityID string,
 	k.setCommunityClaim(ctx, claim.CommunityID, claimID)
 	k.setCreatorClaim(ctx, claim.Creator, claimID)
 	k.setCreatedTimeClaim(ctx, claim.CreatedTime, claimID)
+	k.setStatusClaim(ctx, claim.Status, claimID)
+	k.setDomainClaims(ctx, claim)
+	k.setBodyHashClaim(ctx, claim.CommunityID, claim.Body, claimID)
+	k.insertClaimQueue(ctx, claim.LockTime, claimID)
 
 	logger(ctx).Info("Submitted " + claim.String())
 
@@ -94,13 +125,101 @@ func (k Keeper) EditClaim(ctx sdk.Context, id uint64, body string, editor sdk.Ac
 		err = ErrUnknownClaim(id)
 		return
 	}
+	if claim.Removed() {
+		err = ErrClaimRemoved(id)
+		return
+	}
+	for _, similar := range k.SimilarClaims(ctx, claim.CommunityID, body) {
+		if similar.ID != id {
+			return claim, ErrDuplicateClaim(similar.ID)
+		}
+	}
 
+	k.deleteBodyHashClaim(ctx, claim.CommunityID, claim.Body, id)
 	claim.Body = body
 	k.setClaim(ctx, claim)
+	k.setBodyHashClaim(ctx, claim.CommunityID, claim.Body, id)
 
 	return
 }
 
+// DeleteClaim lets admins archive any claim and creators delete a claim nobody argued on yet.
+// The claim is kept as a tombstone that can still be fetched by id, but it's taken out of
+// every listing and its lifecycle. The claim hooks then remove the stakes on it.
+// The creation stake is refunded, unless an admin archives the claim as spam.
+func (k Keeper) DeleteClaim(ctx sdk.Context, id uint64, remover sdk.AccAddress, spam bool) (claim Claim, err sdk.Error) {
+	jailed, err := k.accountKeeper.IsJailed(ctx, remover)
+	if err != nil {
+		return
+	}
+	if jailed {
+		return claim, ErrCreatorJailed(remover)
+	}
+
+	claim, ok := k.Claim(ctx, id)
+	if !ok {
+		return claim, ErrUnknownClaim(id)
+	}
+	if claim.Removed() {
+		return claim, ErrClaimRemoved(id)
+	}
+
+	eventType := EventTypeClaimArchived
+	switch {
+	case k.isAdmin(ctx, remover):
+		claim.Archived = true
+		claim.Spam = spam
+	case claim.Creator.Equals(remover) && !spam:
+		if !claim.FirstArgumentTime.IsZero() {
+			return claim, ErrClaimHasArguments(id)
+		}
+		claim.Deleted = true
+		eventType = EventTypeClaimDeleted
+	default:
+		return claim, ErrAddressNotAuthorised()
+	}
+
+	k.deleteCommunityClaim(ctx, claim.CommunityID, id)
+	k.deleteCreatorClaim(ctx, claim.Creator, id)
+	k.deleteCreatedTimeClaim(ctx, claim.CreatedTime, id)
+	k.deleteStatusClaim(ctx, claim.Status, id)
+	k.deleteDomainClaims(ctx, claim)
+	k.deleteBodyHashClaim(ctx, claim.CommunityID, claim.Body, id)
+	if claim.Status != StatusResolved {
+		k.removeFromClaimQueue(ctx, claim.nextTransitionTime(), id)
+	}
+
+	if claim.Spam {
+		claim, err = k.forfeitCreationStake(ctx, claim)
+	} else {
+		claim, err = k.refundCreationStake(ctx, claim, false)
+	}
+	if err != nil {
+		return claim, err
+	}
+
+	claim.RemovedBy = remover
+	claim.RemovedTime = ctx.BlockHeader().Time
+	k.setClaim(ctx, claim)
+	err = k.afterClaimRemoved(ctx, claim)
+	if err != nil {
+		return claim, err
+	}
+
+	// the hooks may have updated the stake totals of the claim
+	claim, _ = k.Claim(ctx, id)
+	logger(ctx).Info(fmt.Sprintf("Removed claim %d", id))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(AttributeKeyClaimID, fmt.Sprintf("%d", id)),
+		),
+	)
+
+	return claim, nil
+}
+
 // Claim gets a single claim by its ID
 func (k Keeper) Claim(ctx sdk.Context, id uint64) (claim Claim, ok bool) {
 	store := k.store(ctx)
@@ -209,13 +328,18 @@ func (k Keeper) SubtractChallengeStake(ctx sdk.Context, id uint64, stake sdk.Coi
 	return nil
 }
 
-// SetFirstArgumentTime sets time when first argument was created on a claim
+// SetFirstArgumentTime sets time when first argument was created on a claim.
+// The first argument shows the claim isn't spam, so the creation stake is refunded.
 func (k Keeper) SetFirstArgumentTime(ctx sdk.Context, id uint64, firstArgumentTime time.Time) sdk.Error {
 	claim, ok := k.Claim(ctx, id)
 	if !ok {
 		return ErrUnknownClaim(id)
 	}
 	claim.FirstArgumentTime = firstArgumentTime
+	claim, err := k.refundCreationStake(ctx, claim, true)
+	if err != nil {
+		return err
+	}
 	k.setClaim(ctx, claim)
 
 	return nil
@@ -333,6 +457,18 @@ func (k Keeper) setCreatedTimeClaim(ctx sdk.Context, createdTime time.Time, clai
 	store.Set(createdTimeClaimKey(createdTime, claimID), bz)
 }
 
+func (k Keeper) deleteCommunityClaim(ctx sdk.Context, communityID string, claimID uint64) {
+	k.store(ctx).Delete(communityClaimKey(communityID, claimID))
+}
+
+func (k Keeper) deleteCreatorClaim(ctx sdk.Context, creator sdk.AccAddress, claimID uint64) {
+	k.store(ctx).Delete(creatorClaimKey(creator, claimID))
+}
+
+func (k Keeper) deleteCreatedTimeClaim(ctx sdk.Context, createdTime time.Time, claimID uint64) {
+	k.store(ctx).Delete(createdTimeClaimKey(createdTime, claimID))
+}
+
 // claimsIterator returns an sdk.Iterator for claims from startClaimID to endClaimID
 func (k Keeper) claimsIterator(ctx sdk.Context, startClaimID, endClaimID uint64) sdk.Iterator {
 	store := k.store(ctx)
@@ -1,6 +1,7 @@
 package claim
 
 import (
+	"fmt"
 	"net/url"
 	"testing"
 	"time"
@@ -120,10 +121,12 @@ func TestCreatedTimeClaims(t *testing.T) {
 
 func createFakeClaim(ctx sdk.Context, keeper Keeper) Claim {
 	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Now().UTC()})
-	body := "Preethi can handle liquor better than Aamir."
 	communityID := "crypto"
 	creator := sdk.AccAddress([]byte{1, 2})
 	source := url.URL{}
+	fundAccount(ctx, keeper, creator)
+	id, _ := keeper.claimID(ctx)
+	body := fmt.Sprintf("Preethi can handle liquor better than Aamir %d", id)
 
 	claim, err := keeper.SubmitClaim(ctx, body, communityID, creator, source)
 	if err != nil {
@@ -225,3 +228,95 @@ func TestEditClaim_ErrAddressNotAuthorised(t *testing.T) {
 
 	assert.Equal(t, ErrAddressNotAuthorised().Code(), err.Code())
 }
+
+type recordingClaimHooks struct {
+	removedClaims []uint64
+	mergedClaims  [][2]uint64
+	err           sdk.Error
+}
+
+func (h *recordingClaimHooks) AfterClaimRemoved(_ sdk.Context, claim Claim) sdk.Error {
+	h.removedClaims = append(h.removedClaims, claim.ID)
+	return h.err
+}
+
+func (h *recordingClaimHooks) AfterClaimsMerged(_ sdk.Context, duplicate, canonical Claim) sdk.Error {
+	h.mergedClaims = append(h.mergedClaims, [2]uint64{duplicate.ID, canonical.ID})
+	return h.err
+}
+
+func TestDeleteClaim(t *testing.T) {
+	ctx, keeper := mockDB()
+	hooks := &recordingClaimHooks{}
+	keeper.SetHooks(NewMultiClaimHooks(hooks))
+	assert.Panics(t, func() { keeper.SetHooks(hooks) })
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+
+	claim := fakeClaim(ctx, keeper, "crypto")
+	argued := fakeClaim(ctx, keeper, "crypto")
+	err := keeper.SetFirstArgumentTime(ctx, argued.ID, time.Now())
+	assert.NoError(t, err)
+
+	_, err = keeper.DeleteClaim(ctx, claim.ID, getFakeAdmin(), false)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+	_, err = keeper.DeleteClaim(ctx, argued.ID, argued.Creator, false)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeClaimHasArguments, err.Code())
+	_, err = keeper.DeleteClaim(ctx, 99, admin, false)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeInvalidID, err.Code())
+
+	deleted, err := keeper.DeleteClaim(ctx, claim.ID, claim.Creator, false)
+	assert.NoError(t, err)
+	assert.True(t, deleted.Deleted)
+	assert.False(t, deleted.Archived)
+	assert.Equal(t, claim.Creator, deleted.RemovedBy)
+	_, err = keeper.DeleteClaim(ctx, claim.ID, admin, false)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeClaimRemoved, err.Code())
+
+	archived, err := keeper.DeleteClaim(ctx, argued.ID, admin, false)
+	assert.NoError(t, err)
+	assert.True(t, archived.Archived)
+	assert.False(t, archived.AcceptsArguments())
+	assert.False(t, archived.AcceptsStakes())
+	assert.Equal(t, []uint64{claim.ID, argued.ID}, hooks.removedClaims)
+
+	// the tombstones are kept, but taken out of the listings
+	tombstone, ok := keeper.Claim(ctx, claim.ID)
+	assert.True(t, ok)
+	assert.Equal(t, deleted, tombstone)
+	assert.Len(t, keeper.CommunityClaims(ctx, "crypto"), 0)
+	assert.Len(t, keeper.CreatorClaims(ctx, claim.Creator), 0)
+	assert.Len(t, keeper.ClaimsAfterTime(ctx, time.Time{}), 0)
+	assert.Len(t, keeper.ClaimsByStatus(ctx, StatusOpen), 0)
+
+	_, err = keeper.EditClaim(ctx, claim.ID, "This is the new claim body. Old wasn't gold anymore.", admin)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeClaimRemoved, err.Code())
+
+	// removed claims leave the lifecycle
+	EndBlocker(ctx.WithBlockTime(archived.ResolveTime), keeper)
+	tombstone, _ = keeper.Claim(ctx, argued.ID)
+	assert.Equal(t, StatusOpen, tombstone.Status)
+}
+
+func TestDeleteClaim_HookError(t *testing.T) {
+	ctx, keeper := mockDB()
+	hooks := &recordingClaimHooks{err: sdk.ErrInternal("hook failed")}
+	keeper.SetHooks(NewMultiClaimHooks(hooks))
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	claim := fakeClaim(ctx, keeper, "crypto")
+	duplicate := fakeClaim(ctx, keeper, "crypto")
+
+	// a failing hook fails the removal, the handler reverts its state changes
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := keeper.DeleteClaim(cacheCtx, claim.ID, admin, false)
+	assert.Error(t, err)
+	assert.Equal(t, sdk.CodeInternal, err.Code())
+	_, err = keeper.MergeClaims(ctx, duplicate.ID, claim.ID, admin)
+	assert.Error(t, err)
+	assert.Equal(t, sdk.CodeInternal, err.Code())
+	assert.Len(t, hooks.mergedClaims, 1)
+}
@@ -15,6 +15,11 @@ import (
 // - 0x10<communityID_Bytes><claimID_Bytes>: claimID_Bytes
 // - 0x11<creator_Bytes><claimID_Bytes>: claimID_Bytes
 // - 0x12<createdTime_Bytes><claimID_Bytes>: claimID_Bytes
+// - 0x13<status_Byte><claimID_Bytes>: claimID_Bytes
+// - 0x14<domainLength_Byte><domain_Bytes><claimID_Bytes>: claimID_Bytes
+// - 0x15<communityIDLength_Byte><communityID_Bytes><bodyHash_Bytes><claimID_Bytes>: claimID_Bytes
+//
+// - 0x20<transitionTime_Bytes><claimID_Bytes>: claimID_Bytes
 var (
 	ClaimsKeyPrefix = []byte{0x00}
 	ClaimIDKey      = []byte{0x01}
@@ -22,6 +27,11 @@ var (
 	CommunityClaimsPrefix   = []byte{0x10}
 	CreatorClaimsPrefix     = []byte{0x11}
 	CreatedTimeClaimsPrefix = []byte{0x12}
+	StatusClaimsPrefix      = []byte{0x13}
+	DomainClaimsPrefix      = []byte{0x14}
+	BodyHashClaimsPrefix    = []byte{0x15}
+
+	ClaimQueuePrefix = []byte{0x20}
 )
 
 // key for getting a specific claim from the store
@@ -58,3 +68,42 @@ func createdTimeClaimKey(createdTime time.Time, claimID uint64) []byte {
 	bz := sdk.Uint64ToBigEndian(claimID)
 	return append(createdTimeClaimsKey(createdTime), bz...)
 }
+
+func statusClaimsKey(status ClaimStatus) []byte {
+	return append(StatusClaimsPrefix, byte(status))
+}
+
+func statusClaimKey(status ClaimStatus, claimID uint64) []byte {
+	bz := sdk.Uint64ToBigEndian(claimID)
+	return append(statusClaimsKey(status), bz...)
+}
+
+// domainClaimsKey length prefixes the domain so that no domain is a prefix of another
+func domainClaimsKey(domain string) []byte {
+	return append(append(DomainClaimsPrefix, byte(len(domain))), []byte(domain)...)
+}
+
+func domainClaimKey(domain string, claimID uint64) []byte {
+	bz := sdk.Uint64ToBigEndian(claimID)
+	return append(domainClaimsKey(domain), bz...)
+}
+
+func bodyHashClaimsKey(communityID string, hash []byte) []byte {
+	key := append(append(BodyHashClaimsPrefix, byte(len(communityID))), []byte(communityID)...)
+	return append(key, hash...)
+}
+
+func bodyHashClaimKey(communityID string, hash []byte, claimID uint64) []byte {
+	bz := sdk.Uint64ToBigEndian(claimID)
+	return append(bodyHashClaimsKey(communityID, hash), bz...)
+}
+
+// claimQueueTimeKey gets the claim queue key of the claims moving on by transitionTime
+func claimQueueTimeKey(transitionTime time.Time) []byte {
+	return append(ClaimQueuePrefix, sdk.FormatTimeBytes(transitionTime)...)
+}
+
+func claimQueueKey(transitionTime time.Time, claimID uint64) []byte {
+	bz := sdk.Uint64ToBigEndian(claimID)
+	return append(claimQueueTimeKey(transitionTime), bz...)
+}
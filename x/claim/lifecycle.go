@@ -0,0 +1,110 @@
+package claim
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker called every block, moves claims along their lifecycle
+func EndBlocker(ctx sdk.Context, keeper Keeper) {
+	keeper.processClaimQueue(ctx)
+}
+
+// processClaimQueue locks open claims and resolves locked claims once their time has come
+func (k Keeper) processClaimQueue(ctx sdk.Context) {
+	due := make([]Claim, 0)
+	k.IterateClaimQueue(ctx, ctx.BlockHeader().Time, func(claim Claim) bool {
+		due = append(due, claim)
+		return false
+	})
+	for _, claim := range due {
+		k.removeFromClaimQueue(ctx, claim.nextTransitionTime(), claim.ID)
+		switch claim.Status {
+		case StatusOpen:
+			k.lockClaim(ctx, claim)
+		case StatusLocked:
+			k.resolveClaim(ctx, claim)
+		}
+	}
+}
+
+func (k Keeper) lockClaim(ctx sdk.Context, claim Claim) {
+	k.setClaimStatus(ctx, claim, StatusLocked)
+	k.insertClaimQueue(ctx, claim.ResolveTime, claim.ID)
+	logger(ctx).Info(fmt.Sprintf("Locked claim %d", claim.ID))
+}
+
+func (k Keeper) resolveClaim(ctx sdk.Context, claim Claim) {
+	claim.Verdict = claimVerdict(claim, k.GetParams(ctx).Quorum.Amount)
+	// a failed refund is left unsettled rather than halting the chain
+	refunded, err := k.refundCreationStake(ctx, claim, true)
+	if err != nil {
+		logger(ctx).Error(fmt.Sprintf("Failed refunding creation stake of claim %d: %s", claim.ID, err))
+	} else {
+		claim = refunded
+	}
+	k.setClaimStatus(ctx, claim, StatusResolved)
+	logger(ctx).Info(fmt.Sprintf("Resolved claim %d: %s", claim.ID, claim.Verdict))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeClaimResolved,
+			sdk.NewAttribute(AttributeKeyClaimID, fmt.Sprintf("%d", claim.ID)),
+			sdk.NewAttribute(AttributeKeyVerdict, claim.Verdict.String()),
+		),
+	)
+}
+
+// setClaimStatus moves a claim to a new status and its status association along with it
+func (k Keeper) setClaimStatus(ctx sdk.Context, claim Claim, status ClaimStatus) {
+	k.deleteStatusClaim(ctx, claim.Status, claim.ID)
+	claim.Status = status
+	k.setClaim(ctx, claim)
+	k.setStatusClaim(ctx, claim.Status, claim.ID)
+}
+
+// ClaimsByStatus gets all the claims in a status
+func (k Keeper) ClaimsByStatus(ctx sdk.Context, status ClaimStatus) (claims Claims) {
+	return k.associatedClaims(ctx, statusClaimsKey(status))
+}
+
+func (k Keeper) setStatusClaim(ctx sdk.Context, status ClaimStatus, claimID uint64) {
+	store := k.store(ctx)
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(claimID)
+	store.Set(statusClaimKey(status, claimID), bz)
+}
+
+func (k Keeper) deleteStatusClaim(ctx sdk.Context, status ClaimStatus, claimID uint64) {
+	k.store(ctx).Delete(statusClaimKey(status, claimID))
+}
+
+// insertClaimQueue queues a claim to move on to its next status at transitionTime
+func (k Keeper) insertClaimQueue(ctx sdk.Context, transitionTime time.Time, claimID uint64) {
+	store := k.store(ctx)
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(claimID)
+	store.Set(claimQueueKey(transitionTime, claimID), bz)
+}
+
+func (k Keeper) removeFromClaimQueue(ctx sdk.Context, transitionTime time.Time, claimID uint64) {
+	k.store(ctx).Delete(claimQueueKey(transitionTime, claimID))
+}
+
+// IterateClaimQueue iterates over the claims due to move on by endTime
+func (k Keeper) IterateClaimQueue(ctx sdk.Context, endTime time.Time, cb func(claim Claim) (stop bool)) {
+	store := k.store(ctx)
+	iterator := store.Iterator(ClaimQueuePrefix, sdk.PrefixEndBytes(claimQueueTimeKey(endTime)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var claimID uint64
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &claimID)
+		claim, ok := k.Claim(ctx, claimID)
+		if !ok {
+			panic(fmt.Sprintf("unable to retrieve claim with id %d", claimID))
+		}
+		if cb(claim) {
+			break
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package claim
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	app "github.com/TruStory/truchain/types"
+)
+
+func TestEndBlocker_ClaimLifecycle(t *testing.T) {
+	ctx, keeper := mockDB()
+	ctx = ctx.WithBlockTime(time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC))
+	params := keeper.GetParams(ctx)
+
+	claim := fakeClaim(ctx, keeper, "crypto")
+	assert.Equal(t, StatusOpen, claim.Status)
+	assert.Equal(t, VerdictNone, claim.Verdict)
+	assert.Equal(t, claim.CreatedTime.Add(params.OpenPeriod), claim.LockTime)
+	assert.Equal(t, claim.LockTime.Add(params.LockedPeriod), claim.ResolveTime)
+	assert.Len(t, keeper.ClaimsByStatus(ctx, StatusOpen), 1)
+
+	// nothing happens before the lock time
+	EndBlocker(ctx.WithBlockTime(claim.LockTime.Add(-time.Second)), keeper)
+	claim, _ = keeper.Claim(ctx, claim.ID)
+	assert.Equal(t, StatusOpen, claim.Status)
+
+	ctx = ctx.WithBlockTime(claim.LockTime)
+	EndBlocker(ctx, keeper)
+	claim, _ = keeper.Claim(ctx, claim.ID)
+	assert.Equal(t, StatusLocked, claim.Status)
+	assert.Len(t, keeper.ClaimsByStatus(ctx, StatusOpen), 0)
+	assert.Len(t, keeper.ClaimsByStatus(ctx, StatusLocked), 1)
+
+	err := keeper.AddBackingStake(ctx, claim.ID, params.Quorum)
+	assert.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(claim.ResolveTime).WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, keeper)
+	claim, _ = keeper.Claim(ctx, claim.ID)
+	assert.Equal(t, StatusResolved, claim.Status)
+	assert.Equal(t, VerdictBacked, claim.Verdict)
+	assert.Len(t, keeper.ClaimsByStatus(ctx, StatusLocked), 0)
+	assert.Len(t, keeper.ClaimsByStatus(ctx, StatusResolved), 1)
+
+	assert.True(t, claim.CreationStakeSettled)
+	events := ctx.EventManager().Events()
+	assert.Equal(t, EventTypeClaimResolved, events[len(events)-1].Type)
+
+	// resolved claims leave the queue
+	count := 0
+	keeper.IterateClaimQueue(ctx, claim.ResolveTime.Add(time.Hour*24*365), func(Claim) bool {
+		count++
+		return false
+	})
+	assert.Equal(t, 0, count)
+}
+
+func TestClaimVerdict(t *testing.T) {
+	quorum := sdk.NewInt(app.Shanev * 100)
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewInt64Coin(app.StakeDenom, app.Shanev*amount)
+	}
+
+	assert.Equal(t, VerdictInconclusive, claimVerdict(Claim{TotalBacked: coin(60), TotalChallenged: coin(30)}, quorum))
+	assert.Equal(t, VerdictInconclusive, claimVerdict(Claim{}, quorum))
+	assert.Equal(t, VerdictInconclusive, claimVerdict(Claim{TotalBacked: coin(60), TotalChallenged: coin(60)}, quorum))
+	assert.Equal(t, VerdictBacked, claimVerdict(Claim{TotalBacked: coin(70), TotalChallenged: coin(30)}, quorum))
+	assert.Equal(t, VerdictChallenged, claimVerdict(Claim{TotalBacked: coin(30), TotalChallenged: coin(70)}, quorum))
+}
+
+func TestQueryClaimsByStatus(t *testing.T) {
+	ctx, keeper := mockDB()
+	fakeClaim(ctx, keeper, "crypto")
+	fakeClaim(ctx, keeper, "crypto")
+	querier := NewQuerier(keeper)
+
+	query := func(status ClaimStatus) ([]byte, sdk.Error) {
+		return querier(ctx, []string{QueryClaimsByStatus}, abci.RequestQuery{
+			Path: strings.Join([]string{custom, QueryClaimsByStatus}, "/"),
+			Data: ModuleCodec.MustMarshalJSON(QueryClaimsByStatusParams{Status: status}),
+		})
+	}
+
+	resBytes, err := query(StatusOpen)
+	assert.NoError(t, err)
+	var claims Claims
+	ModuleCodec.MustUnmarshalJSON(resBytes, &claims)
+	assert.Len(t, claims, 2)
+
+	resBytes, err = query(StatusResolved)
+	assert.NoError(t, err)
+	claims = nil
+	ModuleCodec.MustUnmarshalJSON(resBytes, &claims)
+	assert.Len(t, claims, 0)
+
+	_, err = query(ClaimStatus(9))
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeInvalidClaimStatus, err.Code())
+}
+
+func TestInitGenesis_ClaimLifecycle(t *testing.T) {
+	ctx, keeper := mockDB()
+	created := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+	genesis := DefaultGenesisState()
+	params := genesis.Params
+	legacy := Claim{
+		ID:          1,
+		CommunityID: "crypto",
+		Body:        "legacy claim",
+		Creator:     getFakeAdmin(),
+		CreatedTime: created,
+	}
+	genesis.Claims = []Claim{legacy}
+	InitGenesis(ctx, keeper, genesis)
+
+	claim, ok := keeper.Claim(ctx, legacy.ID)
+	assert.True(t, ok)
+	assert.Equal(t, created.Add(params.OpenPeriod), claim.LockTime)
+	assert.Equal(t, claim.LockTime.Add(params.LockedPeriod), claim.ResolveTime)
+	assert.Len(t, keeper.ClaimsByStatus(ctx, StatusOpen), 1)
+
+	ctx = ctx.WithBlockTime(claim.LockTime)
+	EndBlocker(ctx, keeper)
+	claim, _ = keeper.Claim(ctx, legacy.ID)
+	assert.Equal(t, StatusLocked, claim.Status)
+}
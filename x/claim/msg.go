@@ -9,6 +9,12 @@ import (
 const (
 	// TypeMsgCreateClaim represents the type of the message for creating new claim
 	TypeMsgCreateClaim = "create_claim"
+	// TypeMsgDeleteClaim represents the type of the message for deleting or archiving a claim
+	TypeMsgDeleteClaim = "delete_claim"
+	// TypeMsgAddClaimSource represents the type of the message for adding a source to a claim
+	TypeMsgAddClaimSource = "add_claim_source"
+	// TypeMsgMergeClaims represents the type of the message for merging a duplicate claim into another one
+	TypeMsgMergeClaims = "merge_claims"
 	// TypeMsgAddAdmin represents the type of message for adding a new admin
 	TypeMsgAddAdmin = "add_admin"
 	// TypeMsgRemoveAdmin represents the type of message for removeing an admin
@@ -20,6 +26,9 @@ const (
 // verify interface at compile time
 var _ sdk.Msg = &MsgCreateClaim{}
 var _ sdk.Msg = &MsgEditClaim{}
+var _ sdk.Msg = &MsgDeleteClaim{}
+var _ sdk.Msg = &MsgAddClaimSource{}
+var _ sdk.Msg = &MsgMergeClaims{}
 var _ sdk.Msg = &MsgAddAdmin{}
 var _ sdk.Msg = &MsgRemoveAdmin{}
 var _ sdk.Msg = &MsgUpdateParams{}
@@ -63,6 +72,11 @@ func (msg MsgCreateClaim) ValidateBasic() sdk.Error {
 	if len(msg.Creator) == 0 {
 		return sdk.ErrInvalidAddress("Invalid address: " + msg.Creator.String())
 	}
+	if msg.Source != "" {
+		if _, err := parseSourceURL(msg.Source); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -78,10 +92,21 @@ func (msg MsgCreateClaim) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
 }
 
-// MsgDeleteClaim defines a message to submit a story
+// MsgDeleteClaim defines a message to delete a claim, claims removed by admins are archived
 type MsgDeleteClaim struct {
 	ID      uint64         `json:"id"`
 	Creator sdk.AccAddress `json:"creator"`
+	// Spam forfeits the creation stake, only admins can archive claims as spam
+	Spam bool `json:"spam,omitempty"`
+}
+
+// NewMsgDeleteClaim creates a new message to delete a claim
+func NewMsgDeleteClaim(id uint64, creator sdk.AccAddress, spam bool) MsgDeleteClaim {
+	return MsgDeleteClaim{
+		ID:      id,
+		Creator: creator,
+		Spam:    spam,
+	}
 }
 
 // Route is the name of the route for claim
@@ -91,7 +116,7 @@ func (msg MsgDeleteClaim) Route() string {
 
 // Type is the name for the Msg
 func (msg MsgDeleteClaim) Type() string {
-	return ModuleName
+	return TypeMsgDeleteClaim
 }
 
 // ValidateBasic validates basic fields of the Msg
@@ -166,6 +191,120 @@ func (msg MsgEditClaim) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Editor)}
 }
 
+// MsgAddClaimSource defines a message to add a source to a claim
+type MsgAddClaimSource struct {
+	ID      uint64         `json:"id"`
+	URL     string         `json:"url"`
+	Kind    SourceKind     `json:"kind"`
+	Title   string         `json:"title,omitempty"`
+	Creator sdk.AccAddress `json:"creator"`
+}
+
+// NewMsgAddClaimSource creates a new message to add a source to a claim
+func NewMsgAddClaimSource(id uint64, url string, kind SourceKind, title string, creator sdk.AccAddress) MsgAddClaimSource {
+	return MsgAddClaimSource{
+		ID:      id,
+		URL:     url,
+		Kind:    kind,
+		Title:   title,
+		Creator: creator,
+	}
+}
+
+// Route is the name of the route for claim
+func (msg MsgAddClaimSource) Route() string {
+	return RouterKey
+}
+
+// Type is the name for the Msg
+func (msg MsgAddClaimSource) Type() string {
+	return TypeMsgAddClaimSource
+}
+
+// ValidateBasic validates basic fields of the Msg
+func (msg MsgAddClaimSource) ValidateBasic() sdk.Error {
+	if msg.ID == 0 {
+		return ErrUnknownClaim(msg.ID)
+	}
+	if _, err := parseSourceURL(msg.URL); err != nil {
+		return err
+	}
+	if !msg.Kind.Valid() {
+		return ErrInvalidSourceKind(msg.Kind)
+	}
+	if len(msg.Creator) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Creator.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the bytes for Msg signer to sign on
+func (msg MsgAddClaimSource) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners gets the signs of the Msg
+func (msg MsgAddClaimSource) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// MsgMergeClaims defines a message to merge a duplicate claim into the canonical claim
+type MsgMergeClaims struct {
+	DuplicateID uint64         `json:"duplicate_id"`
+	CanonicalID uint64         `json:"canonical_id"`
+	Admin       sdk.AccAddress `json:"admin"`
+}
+
+// NewMsgMergeClaims creates a new message to merge a duplicate claim into the canonical claim
+func NewMsgMergeClaims(duplicateID, canonicalID uint64, admin sdk.AccAddress) MsgMergeClaims {
+	return MsgMergeClaims{
+		DuplicateID: duplicateID,
+		CanonicalID: canonicalID,
+		Admin:       admin,
+	}
+}
+
+// Route is the name of the route for claim
+func (msg MsgMergeClaims) Route() string {
+	return RouterKey
+}
+
+// Type is the name for the Msg
+func (msg MsgMergeClaims) Type() string {
+	return TypeMsgMergeClaims
+}
+
+// ValidateBasic validates basic fields of the Msg
+func (msg MsgMergeClaims) ValidateBasic() sdk.Error {
+	if msg.DuplicateID == 0 {
+		return ErrUnknownClaim(msg.DuplicateID)
+	}
+	if msg.CanonicalID == 0 {
+		return ErrUnknownClaim(msg.CanonicalID)
+	}
+	if msg.DuplicateID == msg.CanonicalID {
+		return ErrInvalidClaimMerge("a claim can't be merged into itself")
+	}
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Admin.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the bytes for Msg signer to sign on
+func (msg MsgMergeClaims) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners gets the signs of the Msg
+func (msg MsgMergeClaims) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Admin)}
+}
+
 // MsgAddAdmin defines the message to add a new admin
 type MsgAddAdmin struct {
 	Admin   sdk.AccAddress `json:"admin"`
@@ -3,7 +3,9 @@ package claim
 import (
 	"fmt"
 	"reflect"
+	"time"
 
+	app "github.com/TruStory/truchain/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
@@ -13,6 +15,13 @@ var (
 	KeyMinClaimLength = []byte("minClaimLength")
 	KeyMaxClaimLength = []byte("maxClaimLength")
 	KeyClaimAdmins    = []byte("claimAdmins")
+	KeyOpenPeriod     = []byte("openPeriod")
+	KeyLockedPeriod   = []byte("lockedPeriod")
+	KeyQuorum         = []byte("quorum")
+
+	KeyClaimCreationStake        = []byte("claimCreationStake")
+	KeyClaimCreationInterestRate = []byte("claimCreationInterestRate")
+	KeyMaxClaimSources           = []byte("maxClaimSources")
 )
 
 // Params holds parameters for a Claim
@@ -20,6 +29,13 @@ type Params struct {
 	MinClaimLength int              `json:"min_claim_length"`
 	MaxClaimLength int              `json:"max_claim_length"`
 	ClaimAdmins    []sdk.AccAddress `json:"claim_admins"`
+	OpenPeriod     time.Duration    `json:"open_period"`
+	LockedPeriod   time.Duration    `json:"locked_period"`
+	Quorum         sdk.Coin         `json:"quorum"`
+
+	ClaimCreationStake        sdk.Coin `json:"claim_creation_stake"`
+	ClaimCreationInterestRate sdk.Dec  `json:"claim_creation_interest_rate"`
+	MaxClaimSources           int      `json:"max_claim_sources"`
 }
 
 // DefaultParams is the Claim params for testing
@@ -28,6 +44,13 @@ func DefaultParams() Params {
 		MinClaimLength: 25,
 		MaxClaimLength: 140,
 		ClaimAdmins:    []sdk.AccAddress{},
+		OpenPeriod:     time.Hour * 24 * 30,
+		LockedPeriod:   time.Hour * 24 * 7,
+		Quorum:         sdk.NewInt64Coin(app.StakeDenom, app.Shanev*100),
+
+		ClaimCreationStake:        sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10),
+		ClaimCreationInterestRate: sdk.ZeroDec(),
+		MaxClaimSources:           5,
 	}
 }
 
@@ -37,6 +60,12 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyMinClaimLength, Value: &p.MinClaimLength},
 		{Key: KeyMaxClaimLength, Value: &p.MaxClaimLength},
 		{Key: KeyClaimAdmins, Value: &p.ClaimAdmins},
+		{Key: KeyOpenPeriod, Value: &p.OpenPeriod},
+		{Key: KeyLockedPeriod, Value: &p.LockedPeriod},
+		{Key: KeyQuorum, Value: &p.Quorum},
+		{Key: KeyClaimCreationStake, Value: &p.ClaimCreationStake},
+		{Key: KeyClaimCreationInterestRate, Value: &p.ClaimCreationInterestRate},
+		{Key: KeyMaxClaimSources, Value: &p.MaxClaimSources},
 	}
 }
 
@@ -19,6 +19,9 @@ const (
 	QueryClaimsIDRange     = "claims_id_range"
 	QueryClaimsBeforeTime  = "claims_before_time"
 	QueryClaimsAfterTime   = "claims_after_time"
+	QueryClaimsByStatus    = "claims_by_status"
+	QueryDomainClaims      = "domain_claims"
+	QuerySimilarClaims     = "similar_claims"
 	QueryParams            = "params"
 )
 
@@ -58,6 +61,22 @@ type QueryClaimsTimeParams struct {
 	CreatedTime time.Time `json:"created_time"`
 }
 
+// QueryClaimsByStatusParams for claims by lifecycle status
+type QueryClaimsByStatusParams struct {
+	Status ClaimStatus `json:"status"`
+}
+
+// QueryDomainClaimsParams for claims citing a domain
+type QueryDomainClaimsParams struct {
+	Domain string `json:"domain"`
+}
+
+// QuerySimilarClaimsParams for the claims of a community with the same normalized body
+type QuerySimilarClaimsParams struct {
+	CommunityID string `json:"community_id"`
+	Body        string `json:"body"`
+}
+
 // NewQuerier returns a function that handles queries on the KVStore
 func NewQuerier(keeper Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
@@ -80,6 +99,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryClaimsBeforeTime(ctx, req, keeper)
 		case QueryClaimsAfterTime:
 			return queryClaimsAfterTime(ctx, req, keeper)
+		case QueryClaimsByStatus:
+			return queryClaimsByStatus(ctx, req, keeper)
+		case QueryDomainClaims:
+			return queryDomainClaims(ctx, req, keeper)
+		case QuerySimilarClaims:
+			return querySimilarClaims(ctx, req, keeper)
 		case QueryParams:
 			return queryParams(ctx, keeper)
 		}
@@ -198,6 +223,42 @@ func queryClaimsAfterTime(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	return mustMarshal(claims)
 }
 
+func queryClaimsByStatus(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryClaimsByStatusParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+	if !params.Status.Valid() {
+		return nil, ErrInvalidClaimStatus(params.Status)
+	}
+	claims := keeper.ClaimsByStatus(ctx, params.Status)
+
+	return mustMarshal(claims)
+}
+
+func queryDomainClaims(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryDomainClaimsParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+	claims := keeper.DomainClaims(ctx, params.Domain)
+
+	return mustMarshal(claims)
+}
+
+func querySimilarClaims(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QuerySimilarClaimsParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+	claims := keeper.SimilarClaims(ctx, params.CommunityID, params.Body)
+
+	return mustMarshal(claims)
+}
+
 func queryParams(ctx sdk.Context, keeper Keeper) (result []byte, err sdk.Error) {
 	params := keeper.GetParams(ctx)
 
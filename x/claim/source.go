@@ -0,0 +1,155 @@
+package claim
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SourceKind is the type of content a claim source links to
+type SourceKind byte
+
+const (
+	SourceArticle SourceKind = iota
+	SourceVideo
+	SourcePaper
+	SourceSocial
+)
+
+var SourceKindName = []string{
+	SourceArticle: "Article",
+	SourceVideo:   "Video",
+	SourcePaper:   "Paper",
+	SourceSocial:  "Social",
+}
+
+func (k SourceKind) String() string {
+	if int(k) >= len(SourceKindName) {
+		return "Unknown"
+	}
+	return SourceKindName[k]
+}
+
+// Valid returns true for a known source kind
+func (k SourceKind) Valid() bool {
+	return int(k) < len(SourceKindName)
+}
+
+// ClaimSource is a link backing up a claim
+type ClaimSource struct {
+	URL       url.URL        `json:"url"`
+	Kind      SourceKind     `json:"kind"`
+	Title     string         `json:"title,omitempty"`
+	AddedBy   sdk.AccAddress `json:"added_by"`
+	AddedTime time.Time      `json:"added_time"`
+}
+
+// Domain returns the domain the source is indexed by
+func (s ClaimSource) Domain() string {
+	return normalizeDomain(s.URL.Hostname())
+}
+
+// normalizeDomain lowercases a domain and drops its www. prefix, so every spelling is indexed alike
+func normalizeDomain(domain string) string {
+	return strings.TrimPrefix(strings.ToLower(domain), "www.")
+}
+
+// parseSourceURL parses a source URL, only absolute http(s) URLs are accepted
+func parseSourceURL(rawURL string) (*url.URL, sdk.Error) {
+	sourceURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, ErrInvalidSourceURL(rawURL)
+	}
+	if sourceURL.Scheme != "http" && sourceURL.Scheme != "https" {
+		return nil, ErrInvalidSourceURL(rawURL)
+	}
+	// the domain index length prefixes hosts with a single byte
+	if sourceURL.Hostname() == "" || len(sourceURL.Hostname()) > 255 {
+		return nil, ErrInvalidSourceURL(rawURL)
+	}
+
+	return sourceURL, nil
+}
+
+// migrateLegacySource adds the single source of claims created before typed sources existed to their sources
+func migrateLegacySource(claim Claim) Claim {
+	if claim.Source.String() == "" {
+		return claim
+	}
+	// the legacy field wasn't validated, sources that can't be indexed stay out
+	if _, err := parseSourceURL(claim.Source.String()); err != nil {
+		return claim
+	}
+	for _, s := range claim.Sources {
+		if s.URL.String() == claim.Source.String() {
+			return claim
+		}
+	}
+	legacy := ClaimSource{URL: claim.Source, Kind: SourceArticle, AddedBy: claim.Creator, AddedTime: claim.CreatedTime}
+	claim.Sources = append([]ClaimSource{legacy}, claim.Sources...)
+	return claim
+}
+
+// AddClaimSource adds a source to a claim, only admins and the claim creator can add sources
+func (k Keeper) AddClaimSource(ctx sdk.Context, id uint64, sourceURL url.URL, kind SourceKind,
+	title string, adder sdk.AccAddress) (claim Claim, err sdk.Error) {
+	claim, ok := k.Claim(ctx, id)
+	if !ok {
+		return claim, ErrUnknownClaim(id)
+	}
+	if claim.Removed() {
+		return claim, ErrClaimRemoved(id)
+	}
+	if !k.isAdmin(ctx, adder) && !claim.Creator.Equals(adder) {
+		return claim, ErrAddressNotAuthorised()
+	}
+	if len(claim.Sources) >= k.GetParams(ctx).MaxClaimSources {
+		return claim, ErrTooManySources(id)
+	}
+	for _, s := range claim.Sources {
+		if s.URL.String() == sourceURL.String() {
+			return claim, ErrDuplicateSource(sourceURL.String())
+		}
+	}
+
+	source := ClaimSource{
+		URL:       sourceURL,
+		Kind:      kind,
+		Title:     title,
+		AddedBy:   adder,
+		AddedTime: ctx.BlockHeader().Time,
+	}
+	claim.Sources = append(claim.Sources, source)
+	k.setClaim(ctx, claim)
+	k.setDomainClaim(ctx, source.Domain(), claim.ID)
+	logger(ctx).Info(fmt.Sprintf("Added source %s to claim %d", sourceURL.String(), claim.ID))
+
+	return claim, nil
+}
+
+// DomainClaims gets all the claims citing a domain
+func (k Keeper) DomainClaims(ctx sdk.Context, domain string) (claims Claims) {
+	return k.associatedClaims(ctx, domainClaimsKey(normalizeDomain(domain)))
+}
+
+func (k Keeper) setDomainClaim(ctx sdk.Context, domain string, claimID uint64) {
+	store := k.store(ctx)
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(claimID)
+	store.Set(domainClaimKey(domain, claimID), bz)
+}
+
+// setDomainClaims indexes a claim by the domains of its sources
+func (k Keeper) setDomainClaims(ctx sdk.Context, claim Claim) {
+	for _, s := range claim.Sources {
+		k.setDomainClaim(ctx, s.Domain(), claim.ID)
+	}
+}
+
+func (k Keeper) deleteDomainClaims(ctx sdk.Context, claim Claim) {
+	for _, s := range claim.Sources {
+		k.store(ctx).Delete(domainClaimKey(s.Domain(), claim.ID))
+	}
+}
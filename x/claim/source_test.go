@@ -0,0 +1,177 @@
+package claim
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestMsgAddClaimSource_ValidateBasic(t *testing.T) {
+	creator := sdk.AccAddress([]byte{1, 2})
+
+	msg := NewMsgAddClaimSource(1, "https://www.example.com/article", SourceArticle, "title", creator)
+	assert.Nil(t, msg.ValidateBasic())
+	assert.Equal(t, ModuleName, msg.Route())
+	assert.Equal(t, TypeMsgAddClaimSource, msg.Type())
+
+	for _, rawURL := range []string{"", "example.com/article", "ftp://example.com/paper", "https:///path", "javascript:alert(1)"} {
+		err := NewMsgAddClaimSource(1, rawURL, SourceArticle, "", creator).ValidateBasic()
+		assert.NotNil(t, err, rawURL)
+		assert.Equal(t, ErrorCodeInvalidSourceURL, err.Code(), rawURL)
+	}
+
+	err := NewMsgAddClaimSource(1, "https://example.com", SourceKind(9), "", creator).ValidateBasic()
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeInvalidSourceKind, err.Code())
+
+	err = NewMsgAddClaimSource(1, "https://example.com", SourceVideo, "", nil).ValidateBasic()
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.ErrInvalidAddress("").Code(), err.Code())
+}
+
+func TestAddClaimSource(t *testing.T) {
+	ctx, keeper := mockDB()
+	claim := fakeClaim(ctx, keeper, "crypto")
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	source := func(rawURL string) url.URL {
+		u, err := url.Parse(rawURL)
+		assert.NoError(t, err)
+		return *u
+	}
+
+	claim, err := keeper.AddClaimSource(ctx, claim.ID, source("https://www.Example.com/a"), SourcePaper, "A paper", claim.Creator)
+	assert.NoError(t, err)
+	assert.Len(t, claim.Sources, 1)
+	assert.Equal(t, SourcePaper, claim.Sources[0].Kind)
+	assert.Equal(t, "A paper", claim.Sources[0].Title)
+	assert.Equal(t, claim.Creator, claim.Sources[0].AddedBy)
+	assert.Equal(t, "example.com", claim.Sources[0].Domain())
+
+	_, err = keeper.AddClaimSource(ctx, claim.ID, source("https://www.Example.com/a"), SourcePaper, "", admin)
+	assert.Equal(t, ErrorCodeDuplicateSource, err.Code())
+
+	stranger := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	_, err = keeper.AddClaimSource(ctx, claim.ID, source("https://other.org"), SourceSocial, "", stranger)
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+
+	_, err = keeper.AddClaimSource(ctx, 99, source("https://other.org"), SourceSocial, "", admin)
+	assert.Equal(t, ErrorCodeInvalidID, err.Code())
+
+	max := keeper.GetParams(ctx).MaxClaimSources
+	for i := len(claim.Sources); i < max; i++ {
+		claim, err = keeper.AddClaimSource(ctx, claim.ID, source(fmt.Sprintf("https://video.net/%d", i)), SourceVideo, "", admin)
+		assert.NoError(t, err)
+	}
+	_, err = keeper.AddClaimSource(ctx, claim.ID, source("https://video.net/z"), SourceVideo, "", admin)
+	assert.Equal(t, ErrorCodeTooManySources, err.Code())
+
+	_, err = keeper.DeleteClaim(ctx, claim.ID, admin, false)
+	assert.NoError(t, err)
+	_, err = keeper.AddClaimSource(ctx, claim.ID, source("https://other.org"), SourceSocial, "", admin)
+	assert.Equal(t, ErrorCodeClaimRemoved, err.Code())
+}
+
+func TestMsgAddClaimSource(t *testing.T) {
+	ctx, keeper := mockDB()
+	handler := NewHandler(keeper)
+	claim := fakeClaim(ctx, keeper, "crypto")
+
+	msg := NewMsgAddClaimSource(claim.ID, "https://example.com/a", SourceArticle, "", claim.Creator)
+	res := handler(ctx, msg)
+	assert.True(t, res.IsOK())
+	var result Claim
+	ModuleCodec.MustUnmarshalJSON(res.Data, &result)
+	assert.Len(t, result.Sources, 1)
+	assert.Equal(t, "https://example.com/a", result.Sources[0].URL.String())
+}
+
+func TestQueryDomainClaims(t *testing.T) {
+	ctx, keeper := mockDB()
+	querier := NewQuerier(keeper)
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	creator := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	fundAccount(ctx, keeper, creator)
+
+	// the source given on creation is indexed too
+	source, _ := url.Parse("https://example.com/a")
+	first, err := keeper.SubmitClaim(ctx, "body string ajsdkhfakjsdfhd", "crypto", creator, *source)
+	assert.NoError(t, err)
+	assert.Len(t, first.Sources, 1)
+
+	second := fakeClaim(ctx, keeper, "crypto")
+	source, _ = url.Parse("http://WWW.example.com/b")
+	_, err = keeper.AddClaimSource(ctx, second.ID, *source, SourceArticle, "", admin)
+	assert.NoError(t, err)
+	fakeClaim(ctx, keeper, "crypto")
+
+	query := func(domain string) Claims {
+		resBytes, err := querier(ctx, []string{QueryDomainClaims}, abci.RequestQuery{
+			Path: strings.Join([]string{custom, QueryDomainClaims}, "/"),
+			Data: ModuleCodec.MustMarshalJSON(QueryDomainClaimsParams{Domain: domain}),
+		})
+		assert.NoError(t, err)
+		var claims Claims
+		ModuleCodec.MustUnmarshalJSON(resBytes, &claims)
+		return claims
+	}
+
+	assert.Len(t, query("example.com"), 2)
+	assert.Len(t, query("www.example.com"), 2)
+	assert.Len(t, query("example.co"), 0)
+
+	_, err = keeper.DeleteClaim(ctx, first.ID, admin, false)
+	assert.NoError(t, err)
+	claims := query("example.com")
+	assert.Len(t, claims, 1)
+	assert.Equal(t, second.ID, claims[0].ID)
+}
+
+func TestMsgCreateClaim_ValidateSource(t *testing.T) {
+	creator := sdk.AccAddress([]byte{1, 2})
+	body := "fake story body with minimum length"
+
+	assert.Nil(t, NewMsgCreateClaim("crypto", body, creator, "").ValidateBasic())
+	assert.Nil(t, NewMsgCreateClaim("crypto", body, creator, "https://example.com/a").ValidateBasic())
+	for _, rawURL := range []string{"example.com/a", "ftp://example.com", "https://" + strings.Repeat("a", 256) + ".com"} {
+		err := NewMsgCreateClaim("crypto", body, creator, rawURL).ValidateBasic()
+		assert.NotNil(t, err, rawURL)
+		assert.Equal(t, ErrorCodeInvalidSourceURL, err.Code(), rawURL)
+	}
+}
+
+func TestInitGenesis_LegacySource(t *testing.T) {
+	ctx, keeper := mockDB()
+	legacySource, _ := url.Parse("https://www.example.com/a")
+	invalidSource, _ := url.Parse("example.org/b")
+	genesis := DefaultGenesisState()
+	genesis.Claims = []Claim{
+		{ID: 1, CommunityID: "crypto", Body: "legacy claim with a source", Creator: getFakeAdmin(), Source: *legacySource},
+		{ID: 2, CommunityID: "crypto", Body: "legacy claim with a bad source", Creator: getFakeAdmin(), Source: *invalidSource},
+	}
+	InitGenesis(ctx, keeper, genesis)
+
+	claim, _ := keeper.Claim(ctx, 1)
+	assert.Len(t, claim.Sources, 1)
+	assert.Equal(t, SourceArticle, claim.Sources[0].Kind)
+	assert.Equal(t, claim.Creator, claim.Sources[0].AddedBy)
+	claims := keeper.DomainClaims(ctx, "example.com")
+	assert.Len(t, claims, 1)
+	assert.Equal(t, uint64(1), claims[0].ID)
+
+	claim, _ = keeper.Claim(ctx, 2)
+	assert.Len(t, claim.Sources, 0)
+
+	// exported claims don't get their legacy source twice
+	exported := ExportGenesis(ctx, keeper)
+	ctx, keeper = mockDB()
+	InitGenesis(ctx, keeper, exported)
+	claim, _ = keeper.Claim(ctx, 1)
+	assert.Len(t, claim.Sources, 1)
+	assert.Len(t, keeper.DomainClaims(ctx, "example.com"), 1)
+}
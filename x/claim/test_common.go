@@ -3,11 +3,16 @@ package claim
 import (
 	"net/url"
 
+	app "github.com/TruStory/truchain/types"
+	trubank "github.com/TruStory/truchain/x/bank"
 	"github.com/TruStory/truchain/x/community"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
@@ -35,21 +40,46 @@ func mockDB() (sdk.Context, Keeper) {
 	communityKey := sdk.NewKVStoreKey("community")
 	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
 	transientParamsKey := sdk.NewTransientStoreKey(params.TStoreKey)
+	accKey := sdk.NewKVStoreKey(auth.StoreKey)
+	bankKey := sdk.NewKVStoreKey(trubank.StoreKey)
+	supplyKey := sdk.NewKVStoreKey(supply.StoreKey)
 
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(claimKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(transientParamsKey, sdk.StoreTypeTransient, db)
 	ms.MountStoreWithDB(communityKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(accKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(bankKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(supplyKey, sdk.StoreTypeIAVL, db)
 	ms.LoadLatestVersion()
 
 	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
 
 	codec := codec.New()
 	cryptoAmino.RegisterAmino(codec)
+	auth.RegisterCodec(codec)
+	supply.RegisterCodec(codec)
 	RegisterCodec(codec)
 
 	pk := params.NewKeeper(codec, paramsKey, transientParamsKey, params.DefaultCodespace)
+	authKeeper := auth.NewAccountKeeper(codec, accKey, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(authKeeper, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, nil)
+	maccPerms := map[string][]string{
+		UserRewardPoolName:        {supply.Burner},
+		UserClaimDepositsPoolName: {supply.Minter, supply.Burner},
+	}
+	supplyKeeper := supply.NewKeeper(codec, supplyKey, authKeeper, bankKeeper, maccPerms)
+	rewardCoins := sdk.NewCoins(sdk.NewInt64Coin(app.StakeDenom, app.Shanev*1000))
+	userRewardAcc := supply.NewEmptyModuleAccount(UserRewardPoolName, supply.Burner)
+	err := userRewardAcc.SetCoins(rewardCoins)
+	if err != nil {
+		panic(err)
+	}
+	supplyKeeper.SetModuleAccount(ctx, userRewardAcc)
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(rewardCoins))
+	trubankKeeper := trubank.NewKeeper(codec, bankKey, bankKeeper, pk.Subspace(trubank.DefaultParamspace), trubank.DefaultCodespace, supplyKeeper)
+	trubank.InitGenesis(ctx, trubankKeeper, trubank.DefaultGenesisState())
 
 	communityKeeper := community.NewKeeper(
 		communityKey,
@@ -61,7 +91,7 @@ func mockDB() (sdk.Context, Keeper) {
 	genesis.Params.CommunityAdmins = append(genesis.Params.CommunityAdmins, admin1, admin2)
 	community.InitGenesis(ctx, communityKeeper, genesis)
 
-	_, err := communityKeeper.NewCommunity(ctx, "Furries", "furry", "", admin1)
+	_, err = communityKeeper.NewCommunity(ctx, "Furries", "furry", "", admin1)
 	if err != nil {
 		panic(err)
 	}
@@ -75,6 +105,8 @@ func mockDB() (sdk.Context, Keeper) {
 		pk.Subspace(ModuleName),
 		codec,
 		accountKeeper,
+		trubankKeeper,
+		supplyKeeper,
 		communityKeeper,
 	)
 	claimGenesis := DefaultGenesisState()
@@ -92,9 +124,10 @@ func getFakeAdmin() (address sdk.AccAddress) {
 }
 
 func fakeClaim(ctx sdk.Context, keeper Keeper, communityID string) Claim {
-	body := "body string ajsdkhfakjsdfhd"
 	creator := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	body := "body string ajsdkhfakjsdfhd " + creator.String()
 	source := url.URL{}
+	fundAccount(ctx, keeper, creator)
 	claim, err := keeper.SubmitClaim(ctx, body, communityID, creator, source)
 	if err != nil {
 		panic(err)
@@ -102,3 +135,11 @@ func fakeClaim(ctx sdk.Context, keeper Keeper, communityID string) Claim {
 
 	return claim
 }
+
+// fundAccount gives an address enough coins to pay for the creation stake of a few claims
+func fundAccount(ctx sdk.Context, keeper Keeper, addr sdk.AccAddress) {
+	_, err := keeper.bankKeeper.AddCoin(ctx, addr, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*100), 0, trubank.TransactionGift)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -15,8 +15,70 @@ const (
 	QuerierRoute      = ModuleName
 	StoreKey          = ModuleName
 	DefaultParamspace = ModuleName
+
+	UserClaimDepositsPoolName = "user_claim_deposits_tokens_pool"
+
+	EventTypeClaimResolved    = "claim-resolved"
+	EventTypeClaimArchived    = "claim-archived"
+	EventTypeClaimDeleted     = "claim-deleted"
+	EventTypeClaimsMerged     = "claims-merged"
+	AttributeKeyClaimID       = "claim-id"
+	AttributeKeyMergedClaimID = "merged-claim-id"
+	AttributeKeyVerdict       = "verdict"
+)
+
+// ClaimStatus is the lifecycle state of a claim.
+// Open claims take arguments and stakes, locked claims only take stakes and resolved claims are closed.
+type ClaimStatus byte
+
+const (
+	StatusOpen ClaimStatus = iota
+	StatusLocked
+	StatusResolved
 )
 
+var ClaimStatusName = []string{
+	StatusOpen:     "Open",
+	StatusLocked:   "Locked",
+	StatusResolved: "Resolved",
+}
+
+func (s ClaimStatus) String() string {
+	if int(s) >= len(ClaimStatusName) {
+		return "Unknown"
+	}
+	return ClaimStatusName[s]
+}
+
+// Valid returns true for a known status
+func (s ClaimStatus) Valid() bool {
+	return int(s) < len(ClaimStatusName)
+}
+
+// Verdict is the outcome of a resolved claim
+type Verdict byte
+
+const (
+	VerdictNone Verdict = iota
+	VerdictBacked
+	VerdictChallenged
+	VerdictInconclusive
+)
+
+var VerdictName = []string{
+	VerdictNone:         "None",
+	VerdictBacked:       "Backed",
+	VerdictChallenged:   "Challenged",
+	VerdictInconclusive: "Inconclusive",
+}
+
+func (v Verdict) String() string {
+	if int(v) >= len(VerdictName) {
+		return "Unknown"
+	}
+	return VerdictName[v]
+}
+
 // Claim stores data about a claim
 type Claim struct {
 	ID                uint64         `json:"id"`
@@ -24,11 +86,26 @@ type Claim struct {
 	Body              string         `json:"body"`
 	Creator           sdk.AccAddress `json:"creator"`
 	Source            url.URL        `json:"source,omitempty"`
+	Sources           []ClaimSource  `json:"sources,omitempty"`
 	TotalStakers      uint64         `json:"total_stakers,omitempty"`
 	TotalBacked       sdk.Coin       `json:"total_backed,omitempty"`
 	TotalChallenged   sdk.Coin       `json:"total_challenged,omitempty"`
 	CreatedTime       time.Time      `json:"created_time"`
 	FirstArgumentTime time.Time      `json:"first_argument_time"`
+	Status            ClaimStatus    `json:"status"`
+	Verdict           Verdict        `json:"verdict"`
+	LockTime          time.Time      `json:"lock_time"`
+	ResolveTime       time.Time      `json:"resolve_time"`
+	Archived          bool           `json:"archived"`
+	Spam              bool           `json:"spam"`
+	Deleted           bool           `json:"deleted"`
+	RemovedBy         sdk.AccAddress `json:"removed_by,omitempty"`
+	RemovedTime       time.Time      `json:"removed_time"`
+	// MergedInto is the canonical claim an archived duplicate was merged into
+	MergedInto uint64 `json:"merged_into,omitempty"`
+	// CreationStake is held until the claim is argued on, resolved or removed
+	CreationStake        sdk.Coin `json:"creation_stake"`
+	CreationStakeSettled bool     `json:"creation_stake_settled"`
 }
 
 // Claims is an array of claims
@@ -49,12 +126,60 @@ func NewClaim(id uint64, communityID string, body string, creator sdk.AccAddress
 	}
 }
 
+// Removed returns true once a claim has been archived or deleted, only its tombstone is left
+func (c Claim) Removed() bool {
+	return c.Archived || c.Deleted
+}
+
+// AcceptsArguments returns true while a claim is open
+func (c Claim) AcceptsArguments() bool {
+	return !c.Removed() && c.Status == StatusOpen
+}
+
+// AcceptsStakes returns true until a claim is resolved
+func (c Claim) AcceptsStakes() bool {
+	return !c.Removed() && c.Status != StatusResolved
+}
+
+// nextTransitionTime returns when an unresolved claim moves to its next status
+func (c Claim) nextTransitionTime() time.Time {
+	if c.Status == StatusOpen {
+		return c.LockTime
+	}
+	return c.ResolveTime
+}
+
+// claimVerdict decides a claim by the side with the most stake.
+// Claims with less stake than the quorum, or with even sides, are inconclusive.
+func claimVerdict(c Claim, quorum sdk.Int) Verdict {
+	backed, challenged := coinAmount(c.TotalBacked), coinAmount(c.TotalChallenged)
+	switch {
+	case backed.Add(challenged).LT(quorum):
+		return VerdictInconclusive
+	case backed.GT(challenged):
+		return VerdictBacked
+	case challenged.GT(backed):
+		return VerdictChallenged
+	default:
+		return VerdictInconclusive
+	}
+}
+
+// coinAmount returns the amount of a coin, claims created before stake totals existed have an empty coin
+func coinAmount(coin sdk.Coin) sdk.Int {
+	if coin.Denom == "" {
+		return sdk.ZeroInt()
+	}
+	return coin.Amount
+}
+
 func (c Claim) String() string {
 	return fmt.Sprintf(`Claim %d:
   CommunityID: %s
   Body:		   %s
   Creator:     %s
   Source:      %s
-  CreatedTime  %s`,
-		c.ID, c.CommunityID, c.Body, c.Creator.String(), c.Source.String(), c.CreatedTime.String())
+  CreatedTime  %s
+  Status:      %s`,
+		c.ID, c.CommunityID, c.Body, c.Creator.String(), c.Source.String(), c.CreatedTime.String(), c.Status.String())
 }
@@ -77,6 +77,7 @@ func mockDB() (sdk.Context, Keeper) {
 		distribution.UserGrowthPoolName: {supply.Burner, supply.Staking},
 		distribution.UserRewardPoolName: {supply.Burner},
 		staking.UserStakesPoolName:      {supply.Minter, supply.Burner},
+		claim.UserClaimDepositsPoolName: {supply.Minter, supply.Burner},
 	}
 
 	paramsKeeper := params.NewKeeper(codec, paramsKey, transientParamsKey, params.DefaultCodespace)
@@ -148,6 +149,8 @@ func mockDB() (sdk.Context, Keeper) {
 		paramsKeeper.Subspace(claim.DefaultParamspace),
 		codec,
 		accountKeeper,
+		trubankKeeper,
+		supplyKeeper,
 		communityKeeper,
 	)
 	claim.InitGenesis(ctx, claimKeeper, claim.DefaultGenesisState())
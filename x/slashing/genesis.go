@@ -10,6 +10,8 @@ import (
 type GenesisState struct {
 	Slashes []Slash `json:"slashes"`
 	Params  Params  `json:"params"`
+	// NextSlashID defaults to the highest imported slash id + 1 when unset
+	NextSlashID uint64 `json:"next_slash_id"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -41,15 +43,36 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 		keeper.setArgumentSlasherSlash(ctx, slash.ArgumentID, slash.ID, slash.Creator)
 
 	}
-	keeper.setSlashID(ctx, uint64(len(data.Slashes)+1))
+	maxID := maxSlashID(data.Slashes)
+	nextID := data.NextSlashID
+	if nextID == 0 {
+		nextID = maxID + 1
+	}
+	if nextID <= maxID {
+		panic(fmt.Sprintf("next slash id %d must be greater than the highest slash id %d", nextID, maxID))
+	}
+	keeper.setSlashID(ctx, nextID)
 	keeper.SetParams(ctx, data.Params)
 }
 
+func maxSlashID(slashes []Slash) uint64 {
+	maxID := uint64(0)
+	for _, slash := range slashes {
+		if slash.ID > maxID {
+			maxID = slash.ID
+		}
+	}
+	return maxID
+}
+
 // ExportGenesis exports the genesis state
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	// an unset id is exported as 0 and defaults on import
+	slashID, _ := keeper.slashID(ctx)
 	return GenesisState{
-		Slashes: keeper.Slashes(ctx),
-		Params:  keeper.GetParams(ctx),
+		Slashes:     keeper.Slashes(ctx),
+		Params:      keeper.GetParams(ctx),
+		NextSlashID: slashID,
 	}
 }
 
@@ -75,5 +98,24 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("Param: CuratorShare, cannot be a negative value")
 	}
 
+	if data.Params.DownvoterShare.IsNegative() {
+		return fmt.Errorf("Param: DownvoterShare, cannot be a negative value")
+	}
+
+	ids := make(map[uint64]bool)
+	for _, slash := range data.Slashes {
+		if ids[slash.ID] {
+			return fmt.Errorf("Slash: duplicate slash id %d", slash.ID)
+		}
+		ids[slash.ID] = true
+		if slash.ArgumentID == 0 {
+			return fmt.Errorf("Slash: %d, must be on an argument", slash.ID)
+		}
+	}
+
+	if data.NextSlashID != 0 && data.NextSlashID <= maxSlashID(data.Slashes) {
+		return fmt.Errorf("NextSlashID: %d, must be greater than every slash id", data.NextSlashID)
+	}
+
 	return nil
 }
@@ -0,0 +1,47 @@
+package slashing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInitGenesis_NextSlashID(t *testing.T) {
+	ctx, keeper := mockDB()
+	admin := keeper.GetParams(ctx).SlashAdmins[0]
+	genesis := ExportGenesis(ctx, keeper)
+	assert.Equal(t, uint64(1), genesis.NextSlashID)
+	genesis.NextSlashID = 0
+
+	genesis.Slashes = []Slash{
+		{ID: 3, ArgumentID: 1, Creator: admin},
+		{ID: 9, ArgumentID: 1, Creator: admin},
+	}
+	InitGenesis(ctx, keeper, genesis)
+	assert.Equal(t, uint64(10), ExportGenesis(ctx, keeper).NextSlashID)
+
+	genesis.NextSlashID = 12
+	InitGenesis(ctx, keeper, genesis)
+	assert.Equal(t, uint64(12), ExportGenesis(ctx, keeper).NextSlashID)
+
+	genesis.NextSlashID = 9
+	assert.Panics(t, func() { InitGenesis(ctx, keeper, genesis) })
+}
+
+func TestValidateGenesis_Slashes(t *testing.T) {
+	ctx, keeper := mockDB()
+	admin := keeper.GetParams(ctx).SlashAdmins[0]
+	genesis := ExportGenesis(ctx, keeper)
+	genesis.NextSlashID = 0
+	genesis.Slashes = []Slash{{ID: 1, ArgumentID: 1, Creator: admin}}
+	assert.NoError(t, ValidateGenesis(genesis))
+
+	genesis.NextSlashID = 1
+	assert.Error(t, ValidateGenesis(genesis))
+	genesis.NextSlashID = 0
+
+	genesis.Slashes = append(genesis.Slashes, Slash{ID: 1, ArgumentID: 2, Creator: admin})
+	assert.Error(t, ValidateGenesis(genesis))
+	genesis.Slashes = []Slash{{ID: 2, Creator: admin}}
+	assert.Error(t, ValidateGenesis(genesis))
+}
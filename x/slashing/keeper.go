@@ -121,6 +121,8 @@ func (k Keeper) refundStake(ctx sdk.Context, stake staking.Stake, communityID st
 		refundType = staking.TransactionChallengeReturned
 	case staking.StakeUpvote:
 		refundType = staking.TransactionUpvoteReturned
+	case staking.StakeDownvote:
+		refundType = staking.TransactionDownvoteReturned
 	default:
 		return staking.ErrCodeInvalidStakeType(stake.Type)
 	}
@@ -137,16 +139,37 @@ func (k Keeper) punish(ctx sdk.Context, argumentID uint64) ([]PunishmentResult,
 	stakingPool := sdk.NewCoin(app.StakeDenom, sdk.ZeroInt())
 	var communityID string
 	punishmentResults := make([]PunishmentResult, 0)
+	downvotes := make([]staking.Stake, 0)
 	for _, stake := range k.stakingKeeper.ArgumentStakes(ctx, argumentID) {
+		// withdrawn stakes already left the argument and paid their penalty
+		if stake.Withdrawn {
+			continue
+		}
 		communityID = stake.CommunityID
+		// downvoters were right, they get their stake back and share the slashed funds.
+		// Expired downvotes were already paid out and don't share in them.
+		if stake.Type == staking.StakeDownvote {
+			if stake.Expired {
+				continue
+			}
+			err := k.refundStake(ctx, stake, communityID)
+			if err != nil {
+				return punishmentResults, err
+			}
+			err = k.stakingKeeper.EndStake(ctx, stake.ID, staking.RewardResultSlashed)
+			if err != nil {
+				return punishmentResults, err
+			}
+			downvotes = append(downvotes, stake)
+			continue
+		}
 		stakingPool = stakingPool.Add(stake.Amount)
 		err := k.refundStake(ctx, stake, communityID)
 		if err != nil {
 			return punishmentResults, err
 		}
 		if !stake.Expired {
-			k.stakingKeeper.RemoveFromActiveStakeQueue(ctx, stake.ID, stake.EndTime)
-			err := k.stakingKeeper.SetStakeExpired(ctx, stake.ID)
+			err := k.stakingKeeper.EndStake(ctx, stake.ID, staking.RewardResultSlashed)
 			if err != nil {
 				return punishmentResults, err
 			}
@@ -223,7 +246,12 @@ func (k Keeper) punish(ctx sdk.Context, argumentID uint64) ([]PunishmentResult,
 		return punishmentResults, sdk.ErrInsufficientCoins("staking pool cannot be empty")
 	}
 
-	return k.rewardCurators(ctx, stakingPool, argumentID, communityID, punishmentResults)
+	punishmentResults, err := k.rewardCurators(ctx, stakingPool, argumentID, communityID, punishmentResults)
+	if err != nil {
+		return punishmentResults, err
+	}
+
+	return k.rewardDownvoters(ctx, stakingPool, downvotes, communityID, punishmentResults)
 }
 
 func (k Keeper) punishCreatorsWithExpiredStake(ctx sdk.Context, stake staking.Stake, communityID string, punishmentResults []PunishmentResult) ([]PunishmentResult, sdk.Error) {
@@ -330,6 +358,42 @@ func (k Keeper) rewardCurators(ctx sdk.Context, stakingPool sdk.Coin, argumentID
 	return punishmentResults, nil
 }
 
+// reward downvoters proportionally to their stake on an argument marked "unhelpful"
+func (k Keeper) rewardDownvoters(ctx sdk.Context, stakingPool sdk.Coin, downvotes []staking.Stake, communityID string, punishmentResults []PunishmentResult) ([]PunishmentResult, sdk.Error) {
+	totalDownvoted := sdk.ZeroInt()
+	for _, downvote := range downvotes {
+		totalDownvoted = totalDownvoted.Add(downvote.Amount.Amount)
+	}
+	if !totalDownvoted.IsPositive() {
+		return punishmentResults, nil
+	}
+
+	totalDownvoterAmountDec := stakingPool.Amount.ToDec().Mul(k.GetParams(ctx).DownvoterShare)
+	for _, downvote := range downvotes {
+		downvoterAmount := totalDownvoterAmountDec.MulInt(downvote.Amount.Amount).QuoInt(totalDownvoted).TruncateInt()
+		downvoterCoin := sdk.NewCoin(app.StakeDenom, downvoterAmount)
+		_, err := k.bankKeeper.AddCoin(
+			ctx,
+			downvote.Creator,
+			downvoterCoin,
+			downvote.ID,
+			bank.TransactionDownvoterReward,
+			WithCommunityID(communityID),
+			FromModuleAccount(staking.UserRewardPoolName))
+		if err != nil {
+			return punishmentResults, err
+		}
+
+		punishmentResults = append(punishmentResults,
+			PunishmentResult{Type: PunishmentDownvoterRewarded,
+				AppAccAddress: downvote.Creator,
+				Coin:          downvoterCoin,
+			})
+	}
+
+	return punishmentResults, nil
+}
+
 // Slash returns a slash by its ID
 func (k Keeper) Slash(ctx sdk.Context, id uint64) (slash Slash, err sdk.Error) {
 	store := k.store(ctx)
@@ -466,7 +530,7 @@ func (k Keeper) validateParams(ctx sdk.Context, argumentID uint64, detailedReaso
 		return ErrAlreadyUnhelpful()
 	}
 
-	if !ok {
+	if !ok || a.Deleted {
 		return ErrInvalidArgument(argumentID)
 	}
 
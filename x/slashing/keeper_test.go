@@ -2,6 +2,7 @@ package slashing
 
 import (
 	"testing"
+	"time"
 
 	"github.com/TruStory/truchain/x/staking"
 
@@ -192,6 +193,113 @@ func Test_punishment(t *testing.T) {
 	assert.Equal(t, "0utru", claim.TotalChallenged.String())
 }
 
+func Test_punishmentRewardsDownvoters(t *testing.T) {
+	ctx, keeper := mockDB()
+	staker := keeper.GetParams(ctx).SlashAdmins[0]
+	slasher := keeper.GetParams(ctx).SlashAdmins[1]
+	_, pubKey, downvoter, coins := getFakeAppAccountParams()
+	_, err := keeper.accountKeeper.CreateAppAccount(ctx, downvoter, coins, pubKey)
+	assert.NoError(t, err)
+	downvoterStartingBalance := keeper.bankKeeper.GetCoins(ctx, downvoter)
+
+	claim, _ := keeper.claimKeeper.Claim(ctx, 1)
+	argument, err := keeper.stakingKeeper.SubmitArgument(ctx, "arg2", "summary2", staker, claim.ID, staking.StakeChallenge)
+	assert.NoError(t, err)
+	stake, _ := keeper.stakingKeeper.Stake(ctx, 2)
+
+	downvote, err := keeper.stakingKeeper.SubmitDownvote(ctx, argument.ID, downvoter)
+	assert.NoError(t, err)
+	// downvotes don't count towards the claim totals
+	claim, _ = keeper.claimKeeper.Claim(ctx, 1)
+	assert.Equal(t, stake.Amount.String(), claim.TotalChallenged.String())
+
+	// this also does a punish because slasher is an admin
+	_, results, err := keeper.CreateSlash(ctx, argument.ID, SlashTypeUnhelpful, SlashReasonPlagiarism, "", slasher)
+	assert.NoError(t, err)
+
+	// downvoter gets the stake back plus the downvoter share of the slashed stakes
+	reward := stake.Amount.Amount.ToDec().Mul(keeper.GetParams(ctx).DownvoterShare).TruncateInt()
+	rewardCoin := sdk.NewCoin(stake.Amount.Denom, reward)
+	downvoterEndingBalance := keeper.bankKeeper.GetCoins(ctx, downvoter)
+	assert.Equal(t, downvoterStartingBalance.Add(sdk.Coins{rewardCoin}).String(), downvoterEndingBalance.String())
+	assert.Contains(t, results, PunishmentResult{Type: PunishmentDownvoterRewarded, AppAccAddress: downvoter, Coin: rewardCoin})
+
+	downvote, _ = keeper.stakingKeeper.Stake(ctx, downvote.ID)
+	assert.True(t, downvote.Expired)
+
+	claim, _ = keeper.claimKeeper.Claim(ctx, 1)
+	assert.Equal(t, "0utru", claim.TotalChallenged.String())
+}
+
+func Test_punishmentSkipsExpiredDownvotes(t *testing.T) {
+	ctx, keeper := mockDB()
+	ctx = ctx.WithBlockTime(time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC))
+	staker := keeper.GetParams(ctx).SlashAdmins[0]
+	slasher := keeper.GetParams(ctx).SlashAdmins[1]
+	_, pubKey, expiredDownvoter, coins := getFakeAppAccountParams()
+	_, err := keeper.accountKeeper.CreateAppAccount(ctx, expiredDownvoter, coins, pubKey)
+	assert.NoError(t, err)
+	_, pubKey, downvoter, coins := getFakeAppAccountParams()
+	_, err = keeper.accountKeeper.CreateAppAccount(ctx, downvoter, coins, pubKey)
+	assert.NoError(t, err)
+
+	argument, err := keeper.stakingKeeper.SubmitArgument(ctx, "arg2", "summary2", staker, 1, staking.StakeChallenge)
+	assert.NoError(t, err)
+	expiredDownvote, err := keeper.stakingKeeper.SubmitDownvote(ctx, argument.ID, expiredDownvoter)
+	assert.NoError(t, err)
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Hour * 24 * 6))
+	downvote, err := keeper.stakingKeeper.SubmitDownvote(ctx, argument.ID, downvoter)
+	assert.NoError(t, err)
+
+	// the first downvote expires before the argument is slashed
+	ctx = ctx.WithBlockTime(expiredDownvote.EndTime.Add(time.Hour))
+	staking.EndBlocker(ctx, keeper.stakingKeeper)
+	expiredDownvote, _ = keeper.stakingKeeper.Stake(ctx, expiredDownvote.ID)
+	assert.True(t, expiredDownvote.Expired)
+	expiredDownvoterBalance := keeper.bankKeeper.GetCoins(ctx, expiredDownvoter)
+
+	_, results, err := keeper.CreateSlash(ctx, argument.ID, SlashTypeUnhelpful, SlashReasonPlagiarism, "", slasher)
+	assert.NoError(t, err)
+
+	// only the active downvote shares the slashed funds
+	for _, result := range results {
+		assert.False(t, result.AppAccAddress.Equals(expiredDownvoter))
+	}
+	assert.Equal(t, expiredDownvoterBalance.String(), keeper.bankKeeper.GetCoins(ctx, expiredDownvoter).String())
+	downvote, _ = keeper.stakingKeeper.Stake(ctx, downvote.ID)
+	assert.True(t, downvote.Expired)
+	rewarded := false
+	for _, result := range results {
+		if result.Type == PunishmentDownvoterRewarded && result.AppAccAddress.Equals(downvoter) {
+			rewarded = true
+		}
+	}
+	assert.True(t, rewarded)
+}
+
+func Test_punishmentKeepsStakingInvariants(t *testing.T) {
+	ctx, keeper := mockDB()
+	staker := keeper.GetParams(ctx).SlashAdmins[0]
+	slasher := keeper.GetParams(ctx).SlashAdmins[1]
+	_, pubKey, upvoter, coins := getFakeAppAccountParams()
+	_, err := keeper.accountKeeper.CreateAppAccount(ctx, upvoter, coins, pubKey)
+	assert.NoError(t, err)
+
+	argument, err := keeper.stakingKeeper.SubmitArgument(ctx, "arg2", "summary2", staker, 1, staking.StakeChallenge)
+	assert.NoError(t, err)
+	upvote, err := keeper.stakingKeeper.SubmitUpvote(ctx, argument.ID, upvoter)
+	assert.NoError(t, err)
+
+	_, _, err = keeper.CreateSlash(ctx, argument.ID, SlashTypeUnhelpful, SlashReasonPlagiarism, "", slasher)
+	assert.NoError(t, err)
+
+	// only the creation stake of the slashed argument leaves the claim totals
+	claim, _ := keeper.claimKeeper.Claim(ctx, 1)
+	assert.Equal(t, upvote.Amount.String(), claim.TotalChallenged.String())
+	msg, broken := staking.AllInvariants(keeper.stakingKeeper)(ctx)
+	assert.False(t, broken, msg)
+}
+
 func TestAddAdmin_Success(t *testing.T) {
 	ctx, keeper := mockDB()
 
@@ -16,6 +16,7 @@ var (
 	KeySlashMinStake           = []byte("slashMinStake")
 	KeySlashAdmins             = []byte("slashAdmins")
 	KeyCuratorShare            = []byte("curatorShare")
+	KeyDownvoterShare          = []byte("downvoterShare")
 	KeyMaxDetailedReasonLength = []byte("maxDetailedReasonLength")
 )
 
@@ -26,6 +27,7 @@ type Params struct {
 	SlashMinStake           sdk.Coin         `json:"slash_min_stake"`
 	SlashAdmins             []sdk.AccAddress `json:"slash_admins"`
 	CuratorShare            sdk.Dec          `json:"curator_share"`
+	DownvoterShare          sdk.Dec          `json:"downvoter_share"`
 	MaxDetailedReasonLength int              `json:"max_detailed_reason_length"`
 }
 
@@ -38,6 +40,7 @@ func DefaultParams() Params {
 		SlashMinStake:           sdk.NewCoin(app.StakeDenom, sdk.NewInt(10*app.Shanev)),
 		SlashAdmins:             []sdk.AccAddress{},
 		CuratorShare:            sdk.NewDecWithPrec(25, 2),
+		DownvoterShare:          sdk.NewDecWithPrec(25, 2),
 		MaxDetailedReasonLength: 140,
 	}
 }
@@ -50,6 +53,7 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeySlashMinStake, Value: &p.SlashMinStake},
 		{Key: KeySlashAdmins, Value: &p.SlashAdmins},
 		{Key: KeyCuratorShare, Value: &p.CuratorShare},
+		{Key: KeyDownvoterShare, Value: &p.DownvoterShare},
 		{Key: KeyMaxDetailedReasonLength, Value: &p.MaxDetailedReasonLength},
 	}
 }
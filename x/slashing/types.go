@@ -36,6 +36,7 @@ const (
 	PunishmentStakeSlashed
 	PunishmentCuratorRewarded
 	PunishmentJailed
+	PunishmentDownvoterRewarded
 )
 
 type PunishmentResult struct {
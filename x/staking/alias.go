@@ -16,6 +16,10 @@ const (
 	TransactionBackingReturned          = exported.TransactionBackingReturned
 	TransactionChallengeReturned        = exported.TransactionChallengeReturned
 	TransactionUpvoteReturned           = exported.TransactionUpvoteReturned
+	TransactionDownvote                 = exported.TransactionDownvote
+	TransactionDownvoteReturned         = exported.TransactionDownvoteReturned
+	TransactionStakeWithdrawalPenalty   = exported.TransactionStakeWithdrawalPenalty
+	TransactionStakeForfeited           = exported.TransactionStakeForfeited
 
 	UserRewardPoolName = distribution.UserRewardPoolName
 )
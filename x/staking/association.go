@@ -13,6 +13,11 @@ func (k Keeper) setClaimArgument(ctx sdk.Context, claimID, argumentID uint64) {
 	k.store(ctx).Set(claimArgumentKey(claimID, argumentID), bz)
 }
 
+// deleteClaimArgument removes a claim <-> argument association from the store
+func (k Keeper) deleteClaimArgument(ctx sdk.Context, claimID, argumentID uint64) {
+	k.store(ctx).Delete(claimArgumentKey(claimID, argumentID))
+}
+
 func (k Keeper) IterateClaimArguments(ctx sdk.Context, claimID uint64, cb func(argument Argument) (stop bool)) {
 	iterator := sdk.KVStorePrefixIterator(k.store(ctx), claimArgumentsPrefix(claimID))
 	defer iterator.Close()
@@ -29,6 +34,45 @@ func (k Keeper) IterateClaimArguments(ctx sdk.Context, claimID uint64, cb func(a
 	}
 }
 
+// setArgumentReply sets a parent argument <-> reply association in the store
+func (k Keeper) setArgumentReply(ctx sdk.Context, parentArgumentID, argumentID uint64) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(argumentID)
+	k.store(ctx).Set(argumentReplyKey(parentArgumentID, argumentID), bz)
+}
+
+// deleteArgumentReply removes a parent argument <-> reply association from the store
+func (k Keeper) deleteArgumentReply(ctx sdk.Context, parentArgumentID, argumentID uint64) {
+	k.store(ctx).Delete(argumentReplyKey(parentArgumentID, argumentID))
+}
+
+func (k Keeper) IterateArgumentReplies(ctx sdk.Context, parentArgumentID uint64, cb func(argument Argument) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), argumentRepliesPrefix(parentArgumentID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var argumentID uint64
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &argumentID)
+		arg, ok := k.Argument(ctx, argumentID)
+		if !ok {
+			panic(fmt.Sprintf("unable to retrieve argument with id %d", argumentID))
+		}
+		if cb(arg) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateArgumentRevisions(ctx sdk.Context, argumentID uint64, cb func(revision ArgumentRevision) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), argumentRevisionsPrefix(argumentID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var revision ArgumentRevision
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &revision)
+		if cb(revision) {
+			break
+		}
+	}
+}
+
 // setArgumentStake sets a argument <-> stake association in the store
 func (k Keeper) setArgumentStake(ctx sdk.Context, argumentID, stakeID uint64) {
 	bz := k.codec.MustMarshalBinaryLengthPrefixed(stakeID)
@@ -79,6 +123,11 @@ func (k Keeper) setUserArgument(ctx sdk.Context, creator sdk.AccAddress, argumen
 	k.store(ctx).Set(userArgumentKey(creator, argumentID), bz)
 }
 
+// deleteUserArgument removes a user <-> argument association from the store
+func (k Keeper) deleteUserArgument(ctx sdk.Context, creator sdk.AccAddress, argumentID uint64) {
+	k.store(ctx).Delete(userArgumentKey(creator, argumentID))
+}
+
 func (k Keeper) IterateUserArguments(ctx sdk.Context, creator sdk.AccAddress, cb func(argument Argument) (stop bool)) {
 	iterator := sdk.KVStorePrefixIterator(k.store(ctx), userArgumentsPrefix(creator))
 	defer iterator.Close()
@@ -195,3 +244,26 @@ func (k Keeper) IterateUserEarnedCoins(ctx sdk.Context, cb userEarnedCoinsCallba
 		}
 	}
 }
+
+type stakeLimitUpgradeCallback func(upgrade StakeLimitUpgrade) (stop bool)
+
+func (k Keeper) iterateStakeLimitUpgrades(iterator sdk.Iterator, cb stakeLimitUpgradeCallback) {
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var upgrade StakeLimitUpgrade
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &upgrade)
+		if cb(upgrade) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateUserStakeLimitUpgrades(ctx sdk.Context, user sdk.AccAddress, cb stakeLimitUpgradeCallback) {
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), userStakeLimitUpgradesPrefix(user))
+	k.iterateStakeLimitUpgrades(iterator, cb)
+}
+
+func (k Keeper) IterateStakeLimitUpgrades(ctx sdk.Context, cb stakeLimitUpgradeCallback) {
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), StakeLimitUpgradesKeyPrefix)
+	k.iterateStakeLimitUpgrades(iterator, cb)
+}
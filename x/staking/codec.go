@@ -6,10 +6,17 @@ import "github.com/cosmos/cosmos-sdk/codec"
 func RegisterCodec(c *codec.Codec) {
 	c.RegisterConcrete(MsgSubmitArgument{}, "truchain/MsgSubmitArgument", nil)
 	c.RegisterConcrete(MsgSubmitUpvote{}, "truchain/MsgUpvoteArgument", nil)
+	c.RegisterConcrete(MsgSubmitDownvote{}, "truchain/MsgSubmitDownvote", nil)
 	c.RegisterConcrete(MsgEditArgument{}, "truchain/MsgEditArgument", nil)
+	c.RegisterConcrete(MsgDeleteArgument{}, "truchain/MsgDeleteArgument", nil)
+	c.RegisterConcrete(MsgWithdrawStake{}, "truchain/MsgWithdrawStake", nil)
+	c.RegisterConcrete(MsgSetStakeAutoRenew{}, "truchain/MsgSetStakeAutoRenew", nil)
 	c.RegisterConcrete(MsgAddAdmin{}, "staking/MsgAddAdmin", nil)
 	c.RegisterConcrete(MsgRemoveAdmin{}, "staking/MsgRemoveAdmin", nil)
 	c.RegisterConcrete(MsgUpdateParams{}, "staking/MsgUpdateParams", nil)
+	c.RegisterConcrete(MsgUpdateCommunityStakingParams{}, "staking/MsgUpdateCommunityStakingParams", nil)
+	c.RegisterConcrete(MsgRemoveCommunityStakingParams{}, "staking/MsgRemoveCommunityStakingParams", nil)
+	c.RegisterConcrete(MsgRetryStakePayout{}, "staking/MsgRetryStakePayout", nil)
 
 	c.RegisterConcrete(Stake{}, "truchain/Stake", nil)
 	c.RegisterConcrete(Argument{}, "truchain/Argument", nil)
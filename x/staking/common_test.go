@@ -25,12 +25,14 @@ import (
 
 type mockedAccountKeeper struct {
 	jailStatus   map[string]bool
+	jailEndTimes map[string]time.Time
 	forceFailure bool
 }
 
 func newAccountKeeper() *mockedAccountKeeper {
 	return &mockedAccountKeeper{
-		jailStatus: make(map[string]bool),
+		jailStatus:   make(map[string]bool),
+		jailEndTimes: make(map[string]time.Time),
 	}
 }
 
@@ -38,6 +40,11 @@ func (m *mockedAccountKeeper) jail(address sdk.AccAddress) {
 	m.jailStatus[address.String()] = true
 }
 
+func (m *mockedAccountKeeper) jailUntil(address sdk.AccAddress, until time.Time) {
+	m.jail(address)
+	m.jailEndTimes[address.String()] = until
+}
+
 func (m *mockedAccountKeeper) fail() {
 	m.forceFailure = true
 }
@@ -62,6 +69,14 @@ func (m *mockedAccountKeeper) UnJail(ctx sdk.Context, address sdk.AccAddress) sd
 	return nil
 }
 
+func (m *mockedAccountKeeper) PrimaryAccount(ctx sdk.Context, address sdk.AccAddress) (account.PrimaryAccount, sdk.Error) {
+	jailed, err := m.IsJailed(ctx, address)
+	if err != nil {
+		return account.PrimaryAccount{}, err
+	}
+	return account.PrimaryAccount{IsJailed: jailed, JailEndTime: m.jailEndTimes[address.String()]}, nil
+}
+
 func (m *mockedAccountKeeper) IterateAppAccounts(ctx sdk.Context, cb func(acc account.AppAccount) (stop bool)) {
 
 }
@@ -133,6 +148,9 @@ func (m *mockClaimKeeper) AddChallengeStake(ctx sdk.Context, id uint64, stake sd
 
 // SubtractBackingStake adds a stake amount to the total backing amount
 func (m *mockClaimKeeper) SubtractBackingStake(ctx sdk.Context, id uint64, stake sdk.Coin) sdk.Error {
+	if !m.enableTrackStake {
+		return nil
+	}
 	c, ok := m.Claim(ctx, id)
 	if !ok {
 		return sdk.ErrInternal("unknown claim")
@@ -145,6 +163,9 @@ func (m *mockClaimKeeper) SubtractBackingStake(ctx sdk.Context, id uint64, stake
 
 // SubtractChallengeStake adds a stake amount to the total challenge amount
 func (m *mockClaimKeeper) SubtractChallengeStake(ctx sdk.Context, id uint64, stake sdk.Coin) sdk.Error {
+	if !m.enableTrackStake {
+		return nil
+	}
 	c, ok := m.Claim(ctx, id)
 	if !ok {
 		return sdk.ErrInternal("unknown claim")
@@ -0,0 +1,142 @@
+package staking
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Granularities of the earnings history
+const (
+	EarningsDaily   = "daily"
+	EarningsWeekly  = "weekly"
+	EarningsMonthly = "monthly"
+)
+
+// EarningsBucket holds what a user earned and had deducted in a community on a day
+type EarningsBucket struct {
+	Address     sdk.AccAddress `json:"address"`
+	CommunityID string         `json:"community_id"`
+	Day         time.Time      `json:"day"`
+	Earned      sdk.Int        `json:"earned"`
+	Deducted    sdk.Int        `json:"deducted"`
+}
+
+// EarningsPeriod sums the earnings buckets of a user from Start until End, using community ids as denoms
+type EarningsPeriod struct {
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+	Earned   sdk.Coins `json:"earned"`
+	Deducted sdk.Coins `json:"deducted"`
+}
+
+// ValidEarningsGranularity returns true for the supported granularities
+func ValidEarningsGranularity(granularity string) bool {
+	return granularity == EarningsDaily || granularity == EarningsWeekly || granularity == EarningsMonthly
+}
+
+// earningsDay truncates a time to the start of its UTC day
+func earningsDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// earningsPeriod returns the period a day belongs to, weeks start on Monday
+func earningsPeriod(day time.Time, granularity string) (start, end time.Time) {
+	switch granularity {
+	case EarningsWeekly:
+		start = day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		return start, start.AddDate(0, 0, 7)
+	case EarningsMonthly:
+		start = time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, 0)
+	default:
+		return day, day.AddDate(0, 0, 1)
+	}
+}
+
+// recordEarnings adds earned and deducted amounts to the bucket of the current day
+func (k Keeper) recordEarnings(ctx sdk.Context, user sdk.AccAddress, communityID string, earned, deducted sdk.Int) {
+	day := earningsDay(ctx.BlockHeader().Time)
+	bucket, ok := k.EarningsBucket(ctx, user, day, communityID)
+	if !ok {
+		bucket = EarningsBucket{
+			Address:     user,
+			CommunityID: communityID,
+			Day:         day,
+			Earned:      sdk.ZeroInt(),
+			Deducted:    sdk.ZeroInt(),
+		}
+	}
+	bucket.Earned = bucket.Earned.Add(earned)
+	bucket.Deducted = bucket.Deducted.Add(deducted)
+	k.setEarningsBucket(ctx, bucket)
+}
+
+// EarningsBucket returns the earnings of a user in a community on a day
+func (k Keeper) EarningsBucket(ctx sdk.Context, user sdk.AccAddress, day time.Time, communityID string) (EarningsBucket, bool) {
+	bucket := EarningsBucket{}
+	bz := k.store(ctx).Get(earningsBucketKey(user, day, communityID))
+	if bz == nil {
+		return bucket, false
+	}
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &bucket)
+	return bucket, true
+}
+
+func (k Keeper) setEarningsBucket(ctx sdk.Context, bucket EarningsBucket) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(bucket)
+	k.store(ctx).Set(earningsBucketKey(bucket.Address, bucket.Day, bucket.CommunityID), bz)
+	k.setCommunityEarnings(ctx, bucket)
+}
+
+// IterateUserEarningsBuckets iterates over the earnings buckets of a user from the day of from until the day of to
+func (k Keeper) IterateUserEarningsBuckets(ctx sdk.Context, user sdk.AccAddress, from, to time.Time,
+	cb func(bucket EarningsBucket) (stop bool)) {
+	iterator := k.store(ctx).Iterator(
+		userEarningsDayPrefix(user, earningsDay(from)),
+		userEarningsDayPrefix(user, earningsDay(to).AddDate(0, 0, 1)),
+	)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bucket EarningsBucket
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &bucket)
+		if cb(bucket) {
+			break
+		}
+	}
+}
+
+// AllEarningsBuckets returns the earnings buckets of every user
+func (k Keeper) AllEarningsBuckets(ctx sdk.Context) []EarningsBucket {
+	buckets := make([]EarningsBucket, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), EarningsBucketsKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bucket EarningsBucket
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &bucket)
+		buckets = append(buckets, bucket)
+	}
+	return buckets
+}
+
+// UserEarningsHistory sums the earnings of a user between two days by period, oldest first.
+// Periods without earnings are left out, an empty community id includes every community.
+func (k Keeper) UserEarningsHistory(ctx sdk.Context, user sdk.AccAddress, communityID string,
+	from, to time.Time, granularity string) []EarningsPeriod {
+	history := make([]EarningsPeriod, 0)
+	k.IterateUserEarningsBuckets(ctx, user, from, to, func(bucket EarningsBucket) bool {
+		if communityID != "" && bucket.CommunityID != communityID {
+			return false
+		}
+		start, end := earningsPeriod(bucket.Day, granularity)
+		if len(history) == 0 || !history[len(history)-1].Start.Equal(start) {
+			history = append(history, EarningsPeriod{Start: start, End: end, Earned: sdk.NewCoins(), Deducted: sdk.NewCoins()})
+		}
+		period := &history[len(history)-1]
+		period.Earned = period.Earned.Add(sdk.NewCoins(sdk.NewCoin(bucket.CommunityID, bucket.Earned)))
+		period.Deducted = period.Deducted.Add(sdk.NewCoins(sdk.NewCoin(bucket.CommunityID, bucket.Deducted)))
+		return false
+	})
+	return history
+}
@@ -0,0 +1,95 @@
+package staking
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	app "github.com/TruStory/truchain/types"
+)
+
+func TestKeeper_UserEarningsHistory(t *testing.T) {
+	ctx, k, _ := mockDB()
+	_, _, addr := keyPubAddr()
+	_, _, addr2 := keyPubAddr()
+
+	// 2019-07-01 is a Monday
+	earn := func(day, communityID string, amount int64) {
+		k.addEarnedCoin(ctx.WithBlockTime(mustParseTime(day)), addr, communityID, sdk.NewInt(amount))
+	}
+	earn("2019-06-30", "crypto", 5)
+	earn("2019-07-01", "crypto", 10)
+	earn("2019-07-01", "crypto", 20)
+	earn("2019-07-02", "random", 7)
+	earn("2019-07-08", "crypto", 1)
+	k.SubtractEarnedCoin(ctx.WithBlockTime(mustParseTime("2019-07-02")), addr, "crypto", sdk.NewInt(3))
+	k.addEarnedCoin(ctx.WithBlockTime(mustParseTime("2019-07-01")), addr2, "crypto", sdk.NewInt(100))
+
+	bucket, ok := k.EarningsBucket(ctx, addr, mustParseTime("2019-07-01"), "crypto")
+	assert.True(t, ok)
+	assert.Equal(t, sdk.NewInt(30), bucket.Earned)
+	assert.Equal(t, sdk.NewInt(0), bucket.Deducted)
+
+	from, to := mustParseTime("2019-07-01"), mustParseTime("2019-07-08")
+	daily := k.UserEarningsHistory(ctx, addr, "", from, to, EarningsDaily)
+	assert.Len(t, daily, 3)
+	assert.Equal(t, mustParseTime("2019-07-01"), daily[0].Start)
+	assert.Equal(t, mustParseTime("2019-07-02"), daily[0].End)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("crypto", 30)), daily[0].Earned)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("random", 7)), daily[1].Earned)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("crypto", 3)), daily[1].Deducted)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("crypto", 1)), daily[2].Earned)
+
+	weekly := k.UserEarningsHistory(ctx, addr, "crypto", mustParseTime("2019-06-30"), to, EarningsWeekly)
+	assert.Len(t, weekly, 3)
+	assert.Equal(t, mustParseTime("2019-06-24"), weekly[0].Start)
+	assert.Equal(t, mustParseTime("2019-07-01"), weekly[1].Start)
+	assert.Equal(t, mustParseTime("2019-07-08"), weekly[1].End)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("crypto", 30)), weekly[1].Earned)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("crypto", 3)), weekly[1].Deducted)
+
+	monthly := k.UserEarningsHistory(ctx, addr, "", mustParseTime("2019-06-01"), mustParseTime("2019-07-31"), EarningsMonthly)
+	assert.Len(t, monthly, 2)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("crypto", 5)), monthly[0].Earned)
+	assert.Equal(t, mustParseTime("2019-08-01"), monthly[1].End)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("crypto", 31), sdk.NewInt64Coin("random", 7)), monthly[1].Earned)
+}
+
+func TestQuerier_UserEarningsHistory(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(mustParseTime("2019-01-01"))
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	_, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	ctx = ctx.WithBlockTime(mustParseTime("2019-01-08"))
+	EndBlocker(ctx, k)
+
+	querier := NewQuerier(k)
+	query := func(params QueryUserEarningsHistoryParams) ([]EarningsPeriod, sdk.Error) {
+		req := abci.RequestQuery{
+			Path: strings.Join([]string{"custom", QuerierRoute, QueryUserEarningsHistory}, "/"),
+			Data: k.codec.MustMarshalJSON(&params),
+		}
+		history := make([]EarningsPeriod, 0)
+		bz, err := querier(ctx, []string{QueryUserEarningsHistory}, req)
+		if err != nil {
+			return history, err
+		}
+		k.codec.MustUnmarshalJSON(bz, &history)
+		return history, nil
+	}
+
+	history, err := query(QueryUserEarningsHistoryParams{Address: addr, From: mustParseTime("2019-01-01")})
+	assert.NoError(t, err)
+	assert.Len(t, history, 1)
+	assert.Equal(t, mustParseTime("2019-01-08"), history[0].Start)
+	assert.Equal(t, k.getEarnedCoins(ctx, addr), history[0].Earned)
+
+	_, err = query(QueryUserEarningsHistoryParams{Address: addr, Granularity: "yearly"})
+	assert.Error(t, err)
+	_, err = query(QueryUserEarningsHistoryParams{Address: addr, From: mustParseTime("2019-02-01"), To: mustParseTime("2019-01-01")})
+	assert.Error(t, err)
+}
@@ -13,29 +13,58 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 
 func (k Keeper) processExpiringStakes(ctx sdk.Context) {
 	logger := k.Logger(ctx)
+	maxExpirations := k.GetParams(ctx).MaxExpirationsPerBlock
 	expiredStakes := make([]Stake, 0)
-	fmt.Println("processing expired stakes")
+	processed := 0
 	k.IterateActiveStakeQueue(ctx, ctx.BlockHeader().Time, func(stake Stake) bool {
+		// the rest of the queue is picked up in the next blocks
+		if processed >= maxExpirations {
+			return true
+		}
+		processed++
 		logger.Info(fmt.Sprintf("Processing expired stakeID %d argumentID %d", stake.ID, stake.ArgumentID))
-		result, err := k.distributeReward(ctx, stake)
+		k.RemoveFromActiveStakeQueue(ctx, stake.ID, stake.EndTime)
+		stake, err := k.expireStake(ctx, stake)
 		if err != nil {
-			panic(err)
+			logger.Error(fmt.Sprintf("Failed paying out stakeID %d: %s", stake.ID, err.Error()))
+			k.addFailedStakePayout(ctx, stake.ID, err)
+			return false
 		}
-		stake.Expired = true
-		stake.Result = &result
-		k.setStake(ctx, stake)
-		k.RemoveFromActiveStakeQueue(ctx, stake.ID, stake.EndTime)
 		expiredStakes = append(expiredStakes, stake)
 		return false
 	})
 
+	k.emitInterestRewardPaid(ctx, expiredStakes)
+}
+
+// expireStake pays out an expired stake. State changes are only committed if the whole payout succeeds.
+func (k Keeper) expireStake(ctx sdk.Context, stake Stake) (Stake, sdk.Error) {
+	cacheCtx, write := ctx.CacheContext()
+	result, err := k.distributeReward(cacheCtx, stake)
+	if err != nil {
+		return stake, err
+	}
+	stake.Expired = true
+	stake.Result = &result
+	k.setStake(cacheCtx, stake)
+	k.afterStakeExpired(cacheCtx, stake, result)
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	if stake.AutoRenew {
+		k.autoRenewStake(ctx, stake)
+	}
+	return stake, nil
+}
+
+func (k Keeper) emitInterestRewardPaid(ctx sdk.Context, expiredStakes []Stake) {
 	if len(expiredStakes) == 0 {
 		return
 	}
 
 	b, err := k.codec.MarshalJSON(expiredStakes)
 	if err != nil {
-		panic(err)
+		k.Logger(ctx).Error(fmt.Sprintf("Failed encoding expired stakes: %s", err.Error()))
+		return
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -45,3 +74,76 @@ func (k Keeper) processExpiringStakes(ctx sdk.Context) {
 		),
 	)
 }
+
+// addFailedStakePayout moves a stake to the failed payouts queue
+func (k Keeper) addFailedStakePayout(ctx sdk.Context, stakeID uint64, err sdk.Error) {
+	failed := FailedStakePayout{
+		StakeID:    stakeID,
+		Error:      err.Error(),
+		FailedTime: ctx.BlockHeader().Time,
+	}
+	k.setFailedStakePayout(ctx, failed)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeStakePayoutFailed,
+			sdk.NewAttribute(AttributeKeyStakeID, fmt.Sprintf("%d", stakeID)),
+			sdk.NewAttribute(AttributeKeyError, err.Error()),
+		),
+	)
+}
+
+func (k Keeper) setFailedStakePayout(ctx sdk.Context, failed FailedStakePayout) {
+	k.store(ctx).Set(failedStakePayoutKey(failed.StakeID), k.codec.MustMarshalBinaryLengthPrefixed(failed))
+}
+
+func (k Keeper) removeFailedStakePayout(ctx sdk.Context, stakeID uint64) {
+	k.store(ctx).Delete(failedStakePayoutKey(stakeID))
+}
+
+// FailedStakePayout returns the failed payout of a stake
+func (k Keeper) FailedStakePayout(ctx sdk.Context, stakeID uint64) (FailedStakePayout, bool) {
+	failed := FailedStakePayout{}
+	bz := k.store(ctx).Get(failedStakePayoutKey(stakeID))
+	if bz == nil {
+		return failed, false
+	}
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &failed)
+	return failed, true
+}
+
+// FailedStakePayouts returns the failed payouts queue
+func (k Keeper) FailedStakePayouts(ctx sdk.Context) []FailedStakePayout {
+	failedPayouts := make([]FailedStakePayout, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), FailedStakePayoutsKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var failed FailedStakePayout
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &failed)
+		failedPayouts = append(failedPayouts, failed)
+	}
+	return failedPayouts
+}
+
+// RetryStakePayout lets an admin retry paying out a stake from the failed payouts queue
+func (k Keeper) RetryStakePayout(ctx sdk.Context, stakeID uint64, admin sdk.AccAddress) (Stake, sdk.Error) {
+	if !k.isAdmin(ctx, admin) {
+		return Stake{}, ErrAddressNotAuthorised()
+	}
+	if _, ok := k.FailedStakePayout(ctx, stakeID); !ok {
+		return Stake{}, ErrCodeUnknownFailedStakePayout(stakeID)
+	}
+	stake, ok := k.Stake(ctx, stakeID)
+	if !ok {
+		return Stake{}, ErrCodeUnknownStake(stakeID)
+	}
+	if stake.Expired {
+		return Stake{}, ErrCodeStakeAlreadyExpired(stakeID)
+	}
+	stake, err := k.expireStake(ctx, stake)
+	if err != nil {
+		return Stake{}, err
+	}
+	k.removeFailedStakePayout(ctx, stakeID)
+	k.emitInterestRewardPaid(ctx, []Stake{stake})
+	return stake, nil
+}
@@ -46,8 +46,8 @@ func TestKeeper_TestEarnedCoins(t *testing.T) {
 	earnings := make(map[string]UserEarnedCoins)
 	earnings[usersEarnings[0].Address.String()] = usersEarnings[0]
 	earnings[usersEarnings[1].Address.String()] = usersEarnings[1]
-	argumentInterest := k.interest(ctx, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50), time.Hour*24*7).RoundInt()
-	upvoteInterest := k.interest(ctx, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10), time.Hour*24*7)
+	argumentInterest := k.interest(ctx, "crypto", sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50), time.Hour*24*7).RoundInt()
+	upvoteInterest := k.interest(ctx, "crypto", sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10), time.Hour*24*7)
 	upvoteAfterSplitInterest := upvoteInterest.Mul(sdk.NewDecWithPrec(50, 2)).RoundInt()
 
 	assert.Equal(t, argumentInterest.String(), earnings[addr.String()].Coins.AmountOf("crypto").String())
@@ -192,8 +192,8 @@ func TestKeeper_TestStakeRewardResult(t *testing.T) {
 	assert.Equal(t, RewardResultUpvoteSplit, stakes[1].Result.Type)
 	assert.Equal(t, RewardResultArgumentCreation, stakesUser2[0].Result.Type)
 
-	argumentInterest := k.interest(ctx, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50), time.Hour*24*7).RoundInt()
-	upvoteInterest := k.interest(ctx, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10), time.Hour*24*7)
+	argumentInterest := k.interest(ctx, "crypto", sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50), time.Hour*24*7).RoundInt()
+	upvoteInterest := k.interest(ctx, "crypto", sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10), time.Hour*24*7)
 	upvoteAfterSplitInterest := upvoteInterest.Mul(sdk.NewDecWithPrec(50, 2)).RoundInt()
 
 	assert.Equal(t, argumentInterest.String(), stakes[0].Result.ArgumentCreatorReward.Amount.String())
@@ -211,3 +211,124 @@ func TestKeeper_TestStakeRewardResult(t *testing.T) {
 	assert.Equal(t, c.AmountOf(app.StakeDenom).String(), result.AmountOf(app.StakeDenom).String())
 
 }
+
+func TestKeeper_TestDownvoteRewardResult(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(mustParseTime("2019-01-01"))
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*250)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*250)})
+
+	argument, err := k.SubmitArgument(ctx, "arg1", "summary1", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	downvote, err := k.SubmitDownvote(ctx, argument.ID, addr2)
+	assert.NoError(t, err)
+
+	projection, err := k.ProjectStake(ctx, downvote)
+	assert.NoError(t, err)
+	assert.Equal(t, RewardResultDownvote, projection.Type)
+	assert.True(t, projection.StakeCreatorReward.IsZero())
+
+	EndBlocker(ctx.WithBlockTime(mustParseTime("2019-01-08")), k)
+	c := mdb.supplyKeeper.GetModuleAccount(ctx, UserStakesPoolName).GetCoins()
+	assert.Equal(t, c.AmountOf(app.StakeDenom).String(), "0")
+
+	// downvoter gets the stake back but loses the interest
+	downvote, ok := k.Stake(ctx, downvote.ID)
+	assert.True(t, ok)
+	assert.True(t, downvote.Expired)
+	assert.NotNil(t, downvote.Result)
+	assert.Equal(t, RewardResultDownvote, downvote.Result.Type)
+	assert.True(t, downvote.Result.StakeCreatorReward.IsZero())
+	assert.True(t, downvote.Result.ArgumentCreatorReward.IsZero())
+
+	balance := mdb.authAccKeeper.GetAccount(ctx, addr2).GetCoins()
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*250).String(), balance.String())
+
+	txs := k.bankKeeper.TransactionsByAddress(ctx, addr2)
+	txTypes := make([]TransactionType, 0, len(txs))
+	for _, tx := range txs {
+		txTypes = append(txTypes, tx.Type)
+	}
+	assert.Equal(t, []TransactionType{TransactionDownvote, TransactionDownvoteReturned}, txTypes)
+	assert.True(t, k.TotalEarnedCoins(ctx, addr2).IsZero())
+}
+
+func TestEndBlocker_MaxExpirationsPerBlock(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	p := k.GetParams(ctx)
+	p.MaxExpirationsPerBlock = 2
+	k.SetParams(ctx, p)
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	for i := 1; i <= 3; i++ {
+		_, err := k.SubmitArgument(ctx.WithBlockTime(mustParseTime("2019-01-01")),
+			"arg", "summary", addr, uint64(i), StakeBacking)
+		assert.NoError(t, err)
+	}
+
+	activeStakes := func() []Stake {
+		stakes := make([]Stake, 0)
+		k.IterateActiveStakeQueue(ctx, mustParseTime("2019-02-01"), func(stake Stake) bool {
+			stakes = append(stakes, stake)
+			return false
+		})
+		return stakes
+	}
+
+	EndBlocker(ctx.WithBlockTime(mustParseTime("2019-01-08")), k)
+	assert.Len(t, activeStakes(), 1)
+	EndBlocker(ctx.WithBlockTime(mustParseTime("2019-01-08")), k)
+	assert.Len(t, activeStakes(), 0)
+
+	for _, stake := range k.UserStakes(ctx, addr) {
+		assert.True(t, stake.Expired)
+		assert.NotNil(t, stake.Result)
+	}
+}
+
+func TestEndBlocker_FailedStakePayout(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	claims := make(map[uint64]claim.Claim)
+	claims[1] = claim.Claim{ID: 1, CommunityID: "crypto", Body: "body", Creator: addr}
+	mockedClaimKeeper.SetClaims(claims)
+	argument, err := k.SubmitArgument(ctx.WithBlockTime(mustParseTime("2019-01-01")),
+		"arg", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+
+	// the claim can't be found so the payout fails
+	mockedClaimKeeper.SetClaims(map[uint64]claim.Claim{2: claims[1]})
+	EndBlocker(ctx.WithBlockTime(mustParseTime("2019-01-08")), k)
+
+	failed := k.FailedStakePayouts(ctx)
+	assert.Len(t, failed, 1)
+	assert.Equal(t, uint64(1), failed[0].StakeID)
+	stake, ok := k.Stake(ctx, 1)
+	assert.True(t, ok)
+	assert.False(t, stake.Expired)
+	pool := mdb.supplyKeeper.GetModuleAccount(ctx, UserStakesPoolName).GetCoins()
+	assert.Equal(t, sdk.Coins{k.GetParams(ctx).ArgumentCreationStake}, pool)
+
+	// processed stakes are not picked up again
+	EndBlocker(ctx.WithBlockTime(mustParseTime("2019-01-09")), k)
+	assert.Len(t, k.FailedStakePayouts(ctx), 1)
+
+	mockedClaimKeeper.SetClaims(claims)
+	_, err = k.RetryStakePayout(ctx, stake.ID, addr)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+
+	admin := k.GetParams(ctx).StakingAdmins[0]
+	stake, err = k.RetryStakePayout(ctx, stake.ID, admin)
+	assert.NoError(t, err)
+	assert.True(t, stake.Expired)
+	assert.NotNil(t, stake.Result)
+	assert.Equal(t, argument.Creator, stake.Result.ArgumentCreator)
+	assert.Len(t, k.FailedStakePayouts(ctx), 0)
+	pool = mdb.supplyKeeper.GetModuleAccount(ctx, UserStakesPoolName).GetCoins()
+	assert.True(t, pool.AmountOf(app.StakeDenom).IsZero())
+
+	_, err = k.RetryStakePayout(ctx, stake.ID, admin)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeUnknownFailedStakePayout, err.Code())
+}
@@ -3,6 +3,7 @@ package staking
 import (
 	"fmt"
 
+	"github.com/TruStory/truchain/x/claim"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -14,29 +15,63 @@ func (e Error) Error() string { return string(e) }
 const (
 	DefaultCodespace sdk.CodespaceType = ModuleName
 
-	ErrorCodeInvalidStakeType                sdk.CodeType = 501
-	ErrorCodeAccountJailed                   sdk.CodeType = 502
-	ErrorCodeInvalidBodyLength               sdk.CodeType = 503
-	ErrorCodeInvalidSummaryLength            sdk.CodeType = 504
-	ErrorCodeUnknownArgument                 sdk.CodeType = 505
-	ErrorCodeUnknownStake                    sdk.CodeType = 506
-	ErrorCodeDuplicateStake                  sdk.CodeType = 507
-	ErrorCodeMaxNumOfArgumentsReached        sdk.CodeType = 508
-	ErrorCodeMaxAmountStakingReached         sdk.CodeType = 509
-	ErrorCodeInvalidQueryParams              sdk.CodeType = 510
-	ErrorCodeJSONParsing                     sdk.CodeType = 511
-	ErrorCodeUnknownClaim                    sdk.CodeType = 512
-	ErrorCodeUnknownStakeType                sdk.CodeType = 513
-	ErrorCodeCannotEditArgumentAlreadyStaked sdk.CodeType = 514
-	ErrorCodeCannotEditArgumentWrongCreator  sdk.CodeType = 515
-	ErrorCodeMinBalance                      sdk.CodeType = 516
-	ErrorCodeAddressNotAuthorised            sdk.CodeType = 517
+	ErrorCodeInvalidStakeType                  sdk.CodeType = 501
+	ErrorCodeAccountJailed                     sdk.CodeType = 502
+	ErrorCodeInvalidBodyLength                 sdk.CodeType = 503
+	ErrorCodeInvalidSummaryLength              sdk.CodeType = 504
+	ErrorCodeUnknownArgument                   sdk.CodeType = 505
+	ErrorCodeUnknownStake                      sdk.CodeType = 506
+	ErrorCodeDuplicateStake                    sdk.CodeType = 507
+	ErrorCodeMaxNumOfArgumentsReached          sdk.CodeType = 508
+	ErrorCodeMaxAmountStakingReached           sdk.CodeType = 509
+	ErrorCodeInvalidQueryParams                sdk.CodeType = 510
+	ErrorCodeJSONParsing                       sdk.CodeType = 511
+	ErrorCodeUnknownClaim                      sdk.CodeType = 512
+	ErrorCodeUnknownStakeType                  sdk.CodeType = 513
+	ErrorCodeCannotEditArgumentAlreadyStaked   sdk.CodeType = 514
+	ErrorCodeCannotEditArgumentWrongCreator    sdk.CodeType = 515
+	ErrorCodeMinBalance                        sdk.CodeType = 516
+	ErrorCodeAddressNotAuthorised              sdk.CodeType = 517
+	ErrorCodeArgumentDeleted                   sdk.CodeType = 518
+	ErrorCodeCannotDeleteArgumentAlreadyStaked sdk.CodeType = 519
+	ErrorCodeCannotDeleteArgumentWrongCreator  sdk.CodeType = 520
+	ErrorCodeStakeAlreadyExpired               sdk.CodeType = 521
+	ErrorCodeCannotWithdrawStakeWrongCreator   sdk.CodeType = 522
+	ErrorCodeInvalidParams                     sdk.CodeType = 523
+	ErrorCodeUnknownCommunityStakingParams     sdk.CodeType = 524
+	ErrorCodeUnknownFailedStakePayout          sdk.CodeType = 525
+	ErrorCodeInvalidParentArgument             sdk.CodeType = 526
+	ErrorCodeMaxArgumentDepthReached           sdk.CodeType = 527
+	ErrorCodeCannotUpdateStakeWrongCreator     sdk.CodeType = 528
+	ErrorCodeArgumentUnhelpful                 sdk.CodeType = 529
+	ErrorCodeClaimClosed                       sdk.CodeType = 530
 )
 
 // GenesisErrors
 const (
 	ErrInvalidArgumentStakeDenom = Error("invalid denomination for argument stake")
 	ErrInvalidUpvoteStakeDenom   = Error("invalid denomination for upvote stake")
+
+	ErrInvalidEarlyWithdrawalPenalty   = Error("early withdrawal penalty must be between 0 and 1")
+	ErrInvalidMinimumBalance           = Error("minimum balance must not be negative")
+	ErrInvalidMaxExpirationsPerBlock   = Error("max expirations per block must be positive")
+	ErrInvalidMaxArgumentDepth         = Error("max argument depth must not be negative")
+	ErrInvalidMinorityInterestBonus    = Error("minority interest bonus must not be negative")
+	ErrInvalidMajorityInterestDiscount = Error("majority interest discount must be at least 0 and less than 1")
+	ErrInvalidPeriod                   = Error("period must be positive")
+	ErrInvalidCreatorShare             = Error("creator share must be between 0 and 1")
+	ErrInvalidInterestRate             = Error("interest rate must not be negative")
+	ErrInvalidCommunityID              = Error("community id must not be empty")
+	ErrUnknownCommunityParam           = Error("param can't be overridden per community")
+	ErrInvalidStakeTiers               = Error("stake tiers must start at zero earned, be sorted by earned threshold and have a positive max stake")
+	ErrDuplicateArgumentID             = Error("argument ids must be unique")
+	ErrDuplicateStakeID                = Error("stake ids must be unique")
+	ErrInvalidNextArgumentID           = Error("next argument id must be greater than every argument id")
+	ErrInvalidNextStakeID              = Error("next stake id must be greater than every stake id")
+	ErrUnknownParentArgument           = Error("argument replies to an unknown argument")
+	ErrUnknownStakeArgument            = Error("stake is on an unknown argument")
+	ErrUnknownRevisionArgument         = Error("argument revision is of an unknown argument")
+	ErrUnknownFailedPayoutStake        = Error("failed stake payout is of an unknown or expired stake")
 )
 
 // ErrCodeAccountJailed throws an error is in jailed status when performing actions.
@@ -135,6 +170,110 @@ func ErrCodeCannotEditArgumentWrongCreator(argumentID uint64) sdk.Error {
 	)
 }
 
+// ErrCodeArgumentDeleted throws an error when acting on an argument that has been deleted
+func ErrCodeArgumentDeleted(argumentID uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeArgumentDeleted,
+		fmt.Sprintf("Argument id %d has been deleted", argumentID),
+	)
+}
+
+// ErrCodeCannotDeleteArgumentAlreadyStaked throws an error when an argument cannot be deleted because it has already been staked
+func ErrCodeCannotDeleteArgumentAlreadyStaked(argumentID uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeCannotDeleteArgumentAlreadyStaked,
+		fmt.Sprintf("This argument cannot be deleted because someone else has already agreed to it"),
+	)
+}
+
+// ErrCodeCannotDeleteArgumentWrongCreator throws an error when an argument cannot be deleted because the delete is not coming from the creator
+func ErrCodeCannotDeleteArgumentWrongCreator(argumentID uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeCannotDeleteArgumentWrongCreator,
+		fmt.Sprintf("This argument cannot be deleted because you are not the writer of the Argument"),
+	)
+}
+
+// ErrCodeStakeAlreadyExpired throws an error when a stake is no longer active
+func ErrCodeStakeAlreadyExpired(stakeID uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeStakeAlreadyExpired,
+		fmt.Sprintf("Stake id %d has already expired", stakeID),
+	)
+}
+
+// ErrCodeCannotWithdrawStakeWrongCreator throws an error when a stake is withdrawn by someone other than its creator
+func ErrCodeCannotWithdrawStakeWrongCreator(stakeID uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeCannotWithdrawStakeWrongCreator,
+		fmt.Sprintf("Stake id %d can only be withdrawn by its creator", stakeID),
+	)
+}
+
+// ErrCodeInvalidParams throws an error when a params update would leave the module in an invalid state
+func ErrCodeInvalidParams(err error) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeInvalidParams,
+		fmt.Sprintf("Invalid params: %s", err.Error()),
+	)
+}
+
+// ErrCodeUnknownCommunityStakingParams throws an error when a community has no staking params overrides
+func ErrCodeUnknownCommunityStakingParams(communityID string) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeUnknownCommunityStakingParams,
+		fmt.Sprintf("Community %s has no staking params overrides", communityID),
+	)
+}
+
+// ErrCodeUnknownFailedStakePayout throws an error when a stake is not in the failed payouts queue
+func ErrCodeUnknownFailedStakePayout(stakeID uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeUnknownFailedStakePayout,
+		fmt.Sprintf("Stake id %d has no failed payout", stakeID),
+	)
+}
+
+// ErrCodeInvalidParentArgument throws an error when replying to an argument of another claim
+func ErrCodeInvalidParentArgument(parentArgumentID, claimID uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeInvalidParentArgument,
+		fmt.Sprintf("Argument id %d doesn't belong to claim id %d", parentArgumentID, claimID),
+	)
+}
+
+// ErrCodeMaxArgumentDepthReached throws an error when a reply is nested too deep
+func ErrCodeMaxArgumentDepthReached(maxDepth int) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeMaxArgumentDepthReached,
+		fmt.Sprintf("Replies can't be nested more than %d levels deep", maxDepth),
+	)
+}
+
+// ErrCodeCannotUpdateStakeWrongCreator throws an error when a user updates a stake they didn't create
+func ErrCodeCannotUpdateStakeWrongCreator(stakeID uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeCannotUpdateStakeWrongCreator,
+		fmt.Sprintf("Stake id %d can only be updated by its creator", stakeID),
+	)
+}
+
+// ErrCodeArgumentUnhelpful throws an error when staking on an argument marked unhelpful
+func ErrCodeArgumentUnhelpful(argumentID uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeArgumentUnhelpful,
+		fmt.Sprintf("Argument id %d was marked unhelpful", argumentID),
+	)
+}
+
+// ErrCodeClaimClosed throws an error when a claim no longer takes arguments or stakes
+func ErrCodeClaimClosed(c claim.Claim) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeClaimClosed,
+		fmt.Sprintf("Claim id %d is %s", c.ID, c.Status),
+	)
+}
+
 // ErrCodeMaxAmountStakingReached throws an error when you already staked.
 func ErrCodeMaxAmountStakingReached() sdk.Error {
 	return sdk.NewError(DefaultCodespace,
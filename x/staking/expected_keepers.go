@@ -11,6 +11,7 @@ import (
 
 type AccountKeeper interface {
 	IsJailed(ctx sdk.Context, address sdk.AccAddress) (bool, sdk.Error)
+	PrimaryAccount(ctx sdk.Context, address sdk.AccAddress) (account.PrimaryAccount, sdk.Error)
 	UnJail(ctx sdk.Context, address sdk.AccAddress) sdk.Error
 	IterateAppAccounts(ctx sdk.Context, cb func(acc account.AppAccount) (stop bool))
 }
@@ -31,6 +32,8 @@ type BankKeeper interface {
 	GetCoins(ctx sdk.Context, address sdk.AccAddress) sdk.Coins
 	SubtractCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
 		referenceID uint64, txType TransactionType, setters ...bankexported.TransactionSetter) (sdk.Coins, sdk.Error)
+	ForfeitCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
+		referenceID uint64, txType TransactionType, setters ...bankexported.TransactionSetter) sdk.Error
 	TransactionsByAddress(ctx sdk.Context, address sdk.AccAddress, filterSetters ...bankexported.Filter) []bankexported.Transaction
 	IterateUserTransactions(sdk.Context, sdk.AccAddress, bool, func(tx bankexported.Transaction) bool)
 }
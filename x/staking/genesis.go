@@ -18,6 +18,17 @@ type GenesisState struct {
 	Params        Params            `json:"params"`
 	Stakes        []Stake           `json:"stakes"`
 	UsersEarnings []UserEarnedCoins `json:"users_earnings"`
+
+	// NextArgumentID and NextStakeID default to the highest imported id + 1 when unset
+	NextArgumentID uint64 `json:"next_argument_id"`
+	NextStakeID    uint64 `json:"next_stake_id"`
+
+	StakeLimitUpgrades     []StakeLimitUpgrade      `json:"stake_limit_upgrades"`
+	CommunityStakingParams []CommunityStakingParams `json:"community_staking_params"`
+	ArgumentRevisions      []ArgumentRevision       `json:"argument_revisions"`
+	EarningsBuckets        []EarningsBucket         `json:"earnings_buckets"`
+	FailedStakePayouts     []FailedStakePayout      `json:"failed_stake_payouts"`
+	UnjailUpvotes          []UnjailUpvotes          `json:"unjail_upvotes"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -27,6 +38,13 @@ func NewGenesisState(arguments []Argument, stakes []Stake, userEarnings []UserEa
 		Params:        params,
 		Stakes:        stakes,
 		UsersEarnings: userEarnings,
+
+		StakeLimitUpgrades:     make([]StakeLimitUpgrade, 0),
+		CommunityStakingParams: make([]CommunityStakingParams, 0),
+		ArgumentRevisions:      make([]ArgumentRevision, 0),
+		EarningsBuckets:        make([]EarningsBucket, 0),
+		FailedStakePayouts:     make([]FailedStakePayout, 0),
+		UnjailUpvotes:          make([]UnjailUpvotes, 0),
 	}
 }
 
@@ -37,21 +55,53 @@ func DefaultGenesisState() GenesisState {
 		Stakes:        make([]Stake, 0),
 		Arguments:     make([]Argument, 0),
 		UsersEarnings: make([]UserEarnedCoins, 0),
+
+		StakeLimitUpgrades:     make([]StakeLimitUpgrade, 0),
+		CommunityStakingParams: make([]CommunityStakingParams, 0),
+		ArgumentRevisions:      make([]ArgumentRevision, 0),
+		EarningsBuckets:        make([]EarningsBucket, 0),
+		FailedStakePayouts:     make([]FailedStakePayout, 0),
+		UnjailUpvotes:          make([]UnjailUpvotes, 0),
 	}
 }
 
 // InitGenesis initializes staking state from genesis file
 func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	// params are set first so stakes without a stored rate can be priced
+	k.SetParams(ctx, data.Params)
+	for _, c := range data.CommunityStakingParams {
+		k.setCommunityStakingParams(ctx, c)
+	}
 	for _, a := range data.Arguments {
 		k.setArgument(ctx, a)
+		// deleted arguments are kept as tombstones without associations
+		if a.Deleted {
+			continue
+		}
 		k.setClaimArgument(ctx, a.ClaimID, a.ID)
 		k.setUserArgument(ctx, a.Creator, a.ID)
+		if a.ParentArgumentID != 0 {
+			k.setArgumentReply(ctx, a.ParentArgumentID, a.ID)
+		}
+	}
+	// stakes that failed to pay out stay in the failed payouts queue instead of the active queue
+	failedPayouts := make(map[uint64]bool)
+	for _, f := range data.FailedStakePayouts {
+		k.setFailedStakePayout(ctx, f)
+		failedPayouts[f.StakeID] = true
 	}
 	mintStakesPool := k.supplyKeeper.GetModuleAccount(ctx, UserStakesPoolName).GetCoins().Empty()
 	for _, s := range data.Stakes {
+		// stakes exported before rates were stored lock in the current rate,
+		// since amino would otherwise persist the missing rate as zero
+		if s.InterestRate.IsNil() {
+			s.InterestRate = k.CommunityParams(ctx, s.CommunityID).InterestRate
+		}
 		k.setStake(ctx, s)
 		if !s.Expired {
-			k.InsertActiveStakeQueue(ctx, s.ID, s.EndTime)
+			if !failedPayouts[s.ID] {
+				k.InsertActiveStakeQueue(ctx, s.ID, s.EndTime)
+			}
 			if mintStakesPool {
 				err := k.supplyKeeper.MintCoins(ctx, UserStakesPoolName, sdk.NewCoins(s.Amount))
 				if err != nil {
@@ -75,8 +125,8 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 		k.setUserCommunityStake(ctx, s.Creator, claim.CommunityID, s.ID)
 
 	}
-	k.setArgumentID(ctx, uint64(len(data.Arguments)+1))
-	k.setStakeID(ctx, uint64(len(data.Stakes)+1))
+	k.setArgumentID(ctx, nextID(data.NextArgumentID, maxArgumentID(data.Arguments), "argument"))
+	k.setStakeID(ctx, nextID(data.NextStakeID, maxStakeID(data.Stakes), "stake"))
 
 	for _, e := range data.UsersEarnings {
 		e.Coins.Sort()
@@ -85,7 +135,18 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 		}
 		k.setEarnedCoins(ctx, e.Address, e.Coins.Sort())
 	}
-	k.SetParams(ctx, data.Params)
+	for _, u := range data.StakeLimitUpgrades {
+		k.setStakeLimitUpgrade(ctx, u)
+	}
+	for _, r := range data.ArgumentRevisions {
+		k.setArgumentRevision(ctx, r)
+	}
+	for _, b := range data.EarningsBuckets {
+		k.setEarningsBucket(ctx, b)
+	}
+	for _, u := range data.UnjailUpvotes {
+		k.setUnjailUpvotes(ctx, u)
+	}
 
 	err := initUserRewardsPool(ctx, k)
 	if err != nil {
@@ -93,6 +154,37 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 	}
 }
 
+// nextID defaults an unset next id to the one following the highest id in use
+func nextID(next, maxID uint64, name string) uint64 {
+	if next == 0 {
+		return maxID + 1
+	}
+	if next <= maxID {
+		panic(fmt.Sprintf("next %s id %d must be greater than the highest %s id %d", name, next, name, maxID))
+	}
+	return next
+}
+
+func maxArgumentID(arguments []Argument) uint64 {
+	maxID := uint64(0)
+	for _, a := range arguments {
+		if a.ID > maxID {
+			maxID = a.ID
+		}
+	}
+	return maxID
+}
+
+func maxStakeID(stakes []Stake) uint64 {
+	maxID := uint64(0)
+	for _, s := range stakes {
+		if s.ID > maxID {
+			maxID = s.ID
+		}
+	}
+	return maxID
+}
+
 func initUserRewardsPool(ctx sdk.Context, keeper Keeper) sdk.Error {
 	userGrowthAcc := keeper.supplyKeeper.GetModuleAccount(ctx, UserRewardPoolName)
 	if userGrowthAcc.GetCoins().Empty() {
@@ -107,11 +199,24 @@ func initUserRewardsPool(ctx sdk.Context, keeper Keeper) sdk.Error {
 
 // ExportGenesis exports the genesis state
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	// unset ids are exported as 0 and default on import
+	argumentID, _ := keeper.argumentID(ctx)
+	stakeID, _ := keeper.stakeID(ctx)
 	return GenesisState{
 		Params:        keeper.GetParams(ctx),
 		Arguments:     keeper.Arguments(ctx),
 		Stakes:        keeper.Stakes(ctx),
 		UsersEarnings: keeper.UsersEarnings(ctx),
+
+		NextArgumentID: argumentID,
+		NextStakeID:    stakeID,
+
+		StakeLimitUpgrades:     keeper.StakeLimitUpgrades(ctx),
+		CommunityStakingParams: keeper.AllCommunityStakingParams(ctx),
+		ArgumentRevisions:      keeper.AllArgumentRevisions(ctx),
+		EarningsBuckets:        keeper.AllEarningsBuckets(ctx),
+		FailedStakePayouts:     keeper.FailedStakePayouts(ctx),
+		UnjailUpvotes:          keeper.AllUnjailUpvotes(ctx),
 	}
 }
 
@@ -123,5 +228,142 @@ func ValidateGenesis(data GenesisState) error {
 	if data.Params.UpvoteStake.Denom != app.StakeDenom {
 		return ErrInvalidUpvoteStakeDenom
 	}
+	penalty := data.Params.EarlyWithdrawalPenalty
+	if penalty.IsNil() || penalty.IsNegative() || penalty.GT(sdk.OneDec()) {
+		return ErrInvalidEarlyWithdrawalPenalty
+	}
+	if isNilInt(data.Params.MinimumBalance) || data.Params.MinimumBalance.IsNegative() {
+		return ErrInvalidMinimumBalance
+	}
+	if data.Params.MaxExpirationsPerBlock <= 0 {
+		return ErrInvalidMaxExpirationsPerBlock
+	}
+	if data.Params.MaxArgumentDepth < 0 {
+		return ErrInvalidMaxArgumentDepth
+	}
+	if err := validateInterestCurve(data.Params); err != nil {
+		return err
+	}
+	if err := validateStakeTiers(data.Params.StakeTiers); err != nil {
+		return err
+	}
+	for _, c := range data.CommunityStakingParams {
+		if err := validateCommunityStakingParams(c); err != nil {
+			return err
+		}
+	}
+	return validateGenesisRecords(data)
+}
+
+// validateGenesisRecords checks ids are unique, below the next ids, and that associations point to imported arguments and stakes
+func validateGenesisRecords(data GenesisState) error {
+	arguments := make(map[uint64]bool)
+	for _, a := range data.Arguments {
+		if arguments[a.ID] {
+			return ErrDuplicateArgumentID
+		}
+		arguments[a.ID] = true
+	}
+	if data.NextArgumentID != 0 && data.NextArgumentID <= maxArgumentID(data.Arguments) {
+		return ErrInvalidNextArgumentID
+	}
+	for _, a := range data.Arguments {
+		if a.ParentArgumentID != 0 && !arguments[a.ParentArgumentID] {
+			return ErrUnknownParentArgument
+		}
+	}
+	stakes := make(map[uint64]bool)
+	activeStakes := make(map[uint64]bool)
+	for _, s := range data.Stakes {
+		if stakes[s.ID] {
+			return ErrDuplicateStakeID
+		}
+		stakes[s.ID] = true
+		activeStakes[s.ID] = !s.Expired
+		if !arguments[s.ArgumentID] {
+			return ErrUnknownStakeArgument
+		}
+	}
+	if data.NextStakeID != 0 && data.NextStakeID <= maxStakeID(data.Stakes) {
+		return ErrInvalidNextStakeID
+	}
+	for _, r := range data.ArgumentRevisions {
+		if !arguments[r.ArgumentID] {
+			return ErrUnknownRevisionArgument
+		}
+	}
+	for _, f := range data.FailedStakePayouts {
+		if !activeStakes[f.StakeID] {
+			return ErrUnknownFailedPayoutStake
+		}
+	}
+	return nil
+}
+
+// isNilInt reports whether an Int was never set, like params missing from an older genesis
+func isNilInt(i sdk.Int) bool {
+	return i == sdk.Int{}
+}
+
+func validateInterestCurve(p Params) error {
+	if p.MinorityInterestBonus.IsNil() || p.MinorityInterestBonus.IsNegative() {
+		return ErrInvalidMinorityInterestBonus
+	}
+	discount := p.MajorityInterestDiscount
+	if discount.IsNil() || discount.IsNegative() || discount.GTE(sdk.OneDec()) {
+		return ErrInvalidMajorityInterestDiscount
+	}
+	return nil
+}
+
+func validateStakeTiers(tiers []StakeTier) error {
+	if len(tiers) == 0 || !tiers[0].EarnedThreshold.IsZero() {
+		return ErrInvalidStakeTiers
+	}
+	for i, tier := range tiers {
+		if !tier.MaxStake.IsPositive() {
+			return ErrInvalidStakeTiers
+		}
+		if i > 0 && !tier.EarnedThreshold.GT(tiers[i-1].EarnedThreshold) {
+			return ErrInvalidStakeTiers
+		}
+	}
+	return nil
+}
+
+// overridableCommunityParams lists the params that can be set per community
+var overridableCommunityParams = []string{
+	"period",
+	"argument_creation_stake",
+	"upvote_stake",
+	"creator_share",
+	"interest_rate",
+}
+
+func validateCommunityStakingParams(c CommunityStakingParams) error {
+	if c.CommunityID == "" {
+		return ErrInvalidCommunityID
+	}
+	for _, param := range c.Overrides {
+		if !isIn(param, overridableCommunityParams) {
+			return ErrUnknownCommunityParam
+		}
+	}
+	if isIn("period", c.Overrides) && c.Period <= 0 {
+		return ErrInvalidPeriod
+	}
+	if isIn("argument_creation_stake", c.Overrides) && c.ArgumentCreationStake.Denom != app.StakeDenom {
+		return ErrInvalidArgumentStakeDenom
+	}
+	if isIn("upvote_stake", c.Overrides) && c.UpvoteStake.Denom != app.StakeDenom {
+		return ErrInvalidUpvoteStakeDenom
+	}
+	if isIn("creator_share", c.Overrides) &&
+		(c.CreatorShare.IsNil() || c.CreatorShare.IsNegative() || c.CreatorShare.GT(sdk.OneDec())) {
+		return ErrInvalidCreatorShare
+	}
+	if isIn("interest_rate", c.Overrides) && (c.InterestRate.IsNil() || c.InterestRate.IsNegative()) {
+		return ErrInvalidInterestRate
+	}
 	return nil
 }
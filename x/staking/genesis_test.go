@@ -1,6 +1,7 @@
 package staking
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -24,39 +25,42 @@ func TestInitGenesis(t *testing.T) {
 	_, _, addr2 := keyPubAddr()
 	ctx = ctx.WithBlockTime(mustParseTime("2019-06-01"))
 	stake1 := Stake{
-		ID:          1,
-		ArgumentID:  1,
-		Type:        StakeBacking,
-		Amount:      sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50),
-		Creator:     addr1,
-		CreatedTime: ctx.BlockHeader().Time,
-		EndTime:     ctx.BlockHeader().Time.Add(time.Hour * 24 * 7),
+		ID:           1,
+		ArgumentID:   1,
+		Type:         StakeBacking,
+		Amount:       sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50),
+		Creator:      addr1,
+		CreatedTime:  ctx.BlockHeader().Time,
+		EndTime:      ctx.BlockHeader().Time.Add(time.Hour * 24 * 7),
+		InterestRate: sdk.NewDecWithPrec(105, 2),
 	}
 
 	stake2 := Stake{
-		ID:          2,
-		ArgumentID:  1,
-		Type:        StakeUpvote,
-		Amount:      sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10),
-		Creator:     addr2,
-		CreatedTime: ctx.BlockHeader().Time,
-		EndTime:     ctx.BlockHeader().Time.Add(time.Hour * 24 * 7),
+		ID:           2,
+		ArgumentID:   1,
+		Type:         StakeUpvote,
+		Amount:       sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10),
+		Creator:      addr2,
+		CreatedTime:  ctx.BlockHeader().Time,
+		EndTime:      ctx.BlockHeader().Time.Add(time.Hour * 24 * 7),
+		InterestRate: sdk.NewDecWithPrec(105, 2),
 	}
 
 	stakes := []Stake{stake1, stake2}
 
 	argument1 := Argument{
-		ID:           1,
-		Creator:      addr1,
-		ClaimID:      1,
-		Summary:      "summary",
-		Body:         "summary with *markdown* [trustory](http://trustory.io). and body, testing cuttoff on a [URL](http://somereally.long.url.even.longer.to.get.140.chars)",
-		StakeType:    StakeBacking,
-		CreatedTime:  ctx.BlockHeader().Time,
-		UpdatedTime:  ctx.BlockHeader().Time,
-		UpvotedCount: 1,
-		UpvotedStake: sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10),
-		TotalStake:   sdk.NewInt64Coin(app.StakeDenom, app.Shanev*60),
+		ID:             1,
+		Creator:        addr1,
+		ClaimID:        1,
+		Summary:        "summary",
+		Body:           "summary with *markdown* [trustory](http://trustory.io). and body, testing cuttoff on a [URL](http://somereally.long.url.even.longer.to.get.140.chars)",
+		StakeType:      StakeBacking,
+		CreatedTime:    ctx.BlockHeader().Time,
+		UpdatedTime:    ctx.BlockHeader().Time,
+		UpvotedCount:   1,
+		UpvotedStake:   sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10),
+		DownvotedStake: sdk.NewInt64Coin(app.StakeDenom, 0),
+		TotalStake:     sdk.NewInt64Coin(app.StakeDenom, app.Shanev*60),
 	}
 
 	expectedSummary := "summary with markdown trustory. and body, testing cuttoff on a URL"
@@ -68,6 +72,40 @@ func TestInitGenesis(t *testing.T) {
 	_, _, admin := keyPubAddr()
 	params.StakingAdmins = append(params.StakingAdmins, admin)
 	genesisState := NewGenesisState(arguments, stakes, usersEarnings, params)
+	genesisState.NextArgumentID = 5
+	genesisState.NextStakeID = 10
+	genesisState.StakeLimitUpgrades = []StakeLimitUpgrade{
+		{
+			Address:      addr1,
+			NewLimit:     1000,
+			EarnedStake:  sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10),
+			Tier:         1,
+			UpgradedTime: mustParseTime("2019-05-20"),
+		},
+	}
+	genesisState.CommunityStakingParams = []CommunityStakingParams{
+		{
+			CommunityID:           "crypto",
+			Period:                time.Hour * 24,
+			ArgumentCreationStake: params.ArgumentCreationStake,
+			UpvoteStake:           params.UpvoteStake,
+			CreatorShare:          params.CreatorShare,
+			InterestRate:          sdk.NewDecWithPrec(50, 2),
+			Overrides:             []string{"period", "interest_rate"},
+		},
+	}
+	genesisState.ArgumentRevisions = []ArgumentRevision{
+		newArgumentRevision(argument1, 0, addr1, argument1.CreatedTime),
+	}
+	genesisState.EarningsBuckets = []EarningsBucket{
+		{
+			Address:     addr1,
+			CommunityID: "crypto",
+			Day:         mustParseTime("2019-05-20"),
+			Earned:      sdk.NewInt(app.Shanev * 10),
+			Deducted:    sdk.NewInt(0),
+		},
+	}
 	InitGenesis(ctx, k, genesisState)
 	actualGenesis := ExportGenesis(ctx, k)
 	assert.Equal(t, genesisState, actualGenesis)
@@ -110,4 +148,179 @@ func TestValidateGenesis(t *testing.T) {
 	err = ValidateGenesis(genesisState)
 	assert.Error(t, err)
 	assert.Equal(t, ErrInvalidUpvoteStakeDenom, err)
+	genesisState.Params.UpvoteStake.Denom = app.StakeDenom
+
+	genesisState.Params.MinimumBalance = sdk.NewInt(-1)
+	err = ValidateGenesis(genesisState)
+	assert.Equal(t, ErrInvalidMinimumBalance, err)
+	genesisState.Params.MinimumBalance = sdk.NewInt(0)
+
+	genesisState.Params.MaxExpirationsPerBlock = 0
+	err = ValidateGenesis(genesisState)
+	assert.Equal(t, ErrInvalidMaxExpirationsPerBlock, err)
+	genesisState.Params.MaxExpirationsPerBlock = 1
+
+	genesisState.Params.StakeTiers = []StakeTier{}
+	err = ValidateGenesis(genesisState)
+	assert.Equal(t, ErrInvalidStakeTiers, err)
+	genesisState.Params.StakeTiers = []StakeTier{
+		{EarnedThreshold: sdk.NewInt(0), MaxStake: sdk.NewInt(app.Shanev * 500)},
+		{EarnedThreshold: sdk.NewInt(0), MaxStake: sdk.NewInt(app.Shanev * 1000)},
+	}
+	err = ValidateGenesis(genesisState)
+	assert.Equal(t, ErrInvalidStakeTiers, err)
+	genesisState.Params.StakeTiers = DefaultStakeTiers()
+	assert.NoError(t, ValidateGenesis(genesisState))
+
+	genesisState.CommunityStakingParams = []CommunityStakingParams{
+		{CommunityID: "crypto", CreatorShare: sdk.NewDecWithPrec(150, 2), Overrides: []string{"creator_share"}},
+	}
+	err = ValidateGenesis(genesisState)
+	assert.Equal(t, ErrInvalidCreatorShare, err)
+	genesisState.CommunityStakingParams[0].Overrides = []string{"min_balance"}
+	err = ValidateGenesis(genesisState)
+	assert.Equal(t, ErrUnknownCommunityParam, err)
+}
+
+func TestValidateGenesis_LegacyParams(t *testing.T) {
+	// params exported before the penalty and minimum balance existed
+	legacy := DefaultParams()
+	bz := ModuleCodec.MustMarshalJSON(legacy)
+	fields := make(map[string]json.RawMessage)
+	assert.NoError(t, json.Unmarshal(bz, &fields))
+	delete(fields, "early_withdrawal_penalty")
+	bz, err := json.Marshal(fields)
+	assert.NoError(t, err)
+	ModuleCodec.MustUnmarshalJSON(bz, &legacy)
+	genesisState := NewGenesisState(nil, nil, nil, legacy)
+	assert.Equal(t, ErrInvalidEarlyWithdrawalPenalty, ValidateGenesis(genesisState))
+
+	delete(fields, "minimum_balance")
+	bz, err = json.Marshal(fields)
+	assert.NoError(t, err)
+	legacy = Params{}
+	ModuleCodec.MustUnmarshalJSON(bz, &legacy)
+	legacy.EarlyWithdrawalPenalty = sdk.ZeroDec()
+	genesisState = NewGenesisState(nil, nil, nil, legacy)
+	assert.Equal(t, ErrInvalidMinimumBalance, ValidateGenesis(genesisState))
+}
+
+func TestValidateGenesis_Records(t *testing.T) {
+	_, _, addr := keyPubAddr()
+	arguments := []Argument{{ID: 2, Creator: addr}, {ID: 5, Creator: addr, ParentArgumentID: 2}}
+	stakes := []Stake{{ID: 3, ArgumentID: 2}, {ID: 7, ArgumentID: 5}}
+	genesisState := NewGenesisState(arguments, stakes, nil, DefaultParams())
+	assert.NoError(t, ValidateGenesis(genesisState))
+
+	genesisState.NextArgumentID = 5
+	assert.Equal(t, ErrInvalidNextArgumentID, ValidateGenesis(genesisState))
+	genesisState.NextArgumentID = 6
+	genesisState.NextStakeID = 7
+	assert.Equal(t, ErrInvalidNextStakeID, ValidateGenesis(genesisState))
+	genesisState.NextStakeID = 0
+	assert.NoError(t, ValidateGenesis(genesisState))
+	genesisState.NextArgumentID = 0
+
+	genesisState.Arguments = append(arguments, Argument{ID: 2})
+	assert.Equal(t, ErrDuplicateArgumentID, ValidateGenesis(genesisState))
+	genesisState.Arguments = append(arguments, Argument{ID: 6, ParentArgumentID: 4})
+	assert.Equal(t, ErrUnknownParentArgument, ValidateGenesis(genesisState))
+	genesisState.Arguments = arguments
+
+	genesisState.Stakes = append(stakes, Stake{ID: 3, ArgumentID: 2})
+	assert.Equal(t, ErrDuplicateStakeID, ValidateGenesis(genesisState))
+	genesisState.Stakes = append(stakes, Stake{ID: 8, ArgumentID: 4})
+	assert.Equal(t, ErrUnknownStakeArgument, ValidateGenesis(genesisState))
+	genesisState.Stakes = stakes
+
+	genesisState.ArgumentRevisions = []ArgumentRevision{{ArgumentID: 4}}
+	assert.Equal(t, ErrUnknownRevisionArgument, ValidateGenesis(genesisState))
+	genesisState.ArgumentRevisions = nil
+
+	genesisState.FailedStakePayouts = []FailedStakePayout{{StakeID: 3}}
+	assert.NoError(t, ValidateGenesis(genesisState))
+	genesisState.FailedStakePayouts = []FailedStakePayout{{StakeID: 4}}
+	assert.Equal(t, ErrUnknownFailedPayoutStake, ValidateGenesis(genesisState))
+	genesisState.Stakes = []Stake{{ID: 3, ArgumentID: 2, Expired: true}}
+	genesisState.FailedStakePayouts = []FailedStakePayout{{StakeID: 3}}
+	assert.Equal(t, ErrUnknownFailedPayoutStake, ValidateGenesis(genesisState))
+}
+
+func TestInitGenesis_NextIDs(t *testing.T) {
+	ctx, k, _ := mockDB()
+	_, _, addr := keyPubAddr()
+	arguments := []Argument{{ID: 2, Creator: addr, ClaimID: 1}, {ID: 5, Creator: addr, ClaimID: 1, Deleted: true}}
+	genesisState := NewGenesisState(arguments, nil, nil, DefaultParams())
+	InitGenesis(ctx, k, genesisState)
+
+	exported := ExportGenesis(ctx, k)
+	assert.Equal(t, uint64(6), exported.NextArgumentID)
+	assert.Equal(t, uint64(1), exported.NextStakeID)
+
+	ctx, k, _ = mockDB()
+	genesisState.NextArgumentID = 3
+	assert.Panics(t, func() { InitGenesis(ctx, k, genesisState) })
+}
+
+func TestInitGenesis_LegacyInterestRate(t *testing.T) {
+	ctx, k, _ := mockDB()
+	_, _, addr := keyPubAddr()
+	arguments := []Argument{{ID: 1, Creator: addr, ClaimID: 1}}
+	stakes := []Stake{
+		{ID: 1, ArgumentID: 1, CommunityID: "crypto", Creator: addr, Amount: sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50), Expired: true},
+		{ID: 2, ArgumentID: 1, CommunityID: "crypto", Creator: addr, Amount: sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10), Expired: true, InterestRate: sdk.ZeroDec()},
+	}
+	genesisState := NewGenesisState(arguments, stakes, nil, DefaultParams())
+	InitGenesis(ctx, k, genesisState)
+
+	// a stake without a rate takes the current rate, a zero rate is kept
+	legacy, ok := k.Stake(ctx, 1)
+	assert.True(t, ok)
+	assert.Equal(t, DefaultParams().InterestRate, legacy.InterestRate)
+	zero, ok := k.Stake(ctx, 2)
+	assert.True(t, ok)
+	assert.True(t, zero.InterestRate.IsZero())
+}
+
+func TestInitGenesis_FailedStakePayouts(t *testing.T) {
+	ctx, k, _ := mockDB()
+	_, _, addr := keyPubAddr()
+	arguments := []Argument{{ID: 1, Creator: addr, ClaimID: 1}}
+	endTime := mustParseTime("2019-01-08")
+	stakes := []Stake{
+		{ID: 1, ArgumentID: 1, CommunityID: "crypto", Creator: addr, Amount: sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50), EndTime: endTime},
+		{ID: 2, ArgumentID: 1, CommunityID: "crypto", Creator: addr, Amount: sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10), EndTime: endTime},
+	}
+	genesisState := NewGenesisState(arguments, stakes, nil, DefaultParams())
+	failed := FailedStakePayout{StakeID: 1, Error: "unknown claim", FailedTime: endTime}
+	genesisState.FailedStakePayouts = []FailedStakePayout{failed}
+	assert.NoError(t, ValidateGenesis(genesisState))
+	InitGenesis(ctx, k, genesisState)
+
+	// the failed stake stays out of the active queue
+	queued := make([]uint64, 0)
+	k.IterateActiveStakeQueue(ctx, endTime, func(stake Stake) bool {
+		queued = append(queued, stake.ID)
+		return false
+	})
+	assert.Equal(t, []uint64{2}, queued)
+	assert.Equal(t, []FailedStakePayout{failed}, k.FailedStakePayouts(ctx))
+
+	exported := ExportGenesis(ctx, k)
+	assert.Equal(t, []FailedStakePayout{failed}, exported.FailedStakePayouts)
+}
+
+func TestInitGenesis_UnjailUpvotes(t *testing.T) {
+	ctx, k, _ := mockDB()
+	_, _, addr := keyPubAddr()
+	genesisState := NewGenesisState(nil, nil, nil, DefaultParams())
+	progress := UnjailUpvotes{Address: addr, JailEndTime: mustParseTime("2019-08-01"), Upvotes: 3}
+	genesisState.UnjailUpvotes = []UnjailUpvotes{progress}
+	InitGenesis(ctx, k, genesisState)
+
+	stored, ok := k.unjailUpvotes(ctx, addr)
+	assert.True(t, ok)
+	assert.Equal(t, progress, stored)
+	exported := ExportGenesis(ctx, k)
+	assert.Equal(t, []UnjailUpvotes{progress}, exported.UnjailUpvotes)
 }
@@ -14,14 +14,28 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgSubmitArgument(ctx, keeper, msg)
 		case MsgSubmitUpvote:
 			return handleMsgSubmitUpvote(ctx, keeper, msg)
+		case MsgSubmitDownvote:
+			return handleMsgSubmitDownvote(ctx, keeper, msg)
 		case MsgEditArgument:
 			return handleMsgEditArgument(ctx, keeper, msg)
+		case MsgDeleteArgument:
+			return handleMsgDeleteArgument(ctx, keeper, msg)
+		case MsgWithdrawStake:
+			return handleMsgWithdrawStake(ctx, keeper, msg)
+		case MsgSetStakeAutoRenew:
+			return handleMsgSetStakeAutoRenew(ctx, keeper, msg)
 		case MsgAddAdmin:
 			return handleMsgAddAdmin(ctx, keeper, msg)
 		case MsgRemoveAdmin:
 			return handleMsgRemoveAdmin(ctx, keeper, msg)
 		case MsgUpdateParams:
 			return handleMsgUpdateParams(ctx, keeper, msg)
+		case MsgUpdateCommunityStakingParams:
+			return handleMsgUpdateCommunityStakingParams(ctx, keeper, msg)
+		case MsgRemoveCommunityStakingParams:
+			return handleMsgRemoveCommunityStakingParams(ctx, keeper, msg)
+		case MsgRetryStakePayout:
+			return handleMsgRetryStakePayout(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized staking message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -33,10 +47,26 @@ func handleMsgSubmitArgument(ctx sdk.Context, keeper Keeper, msg MsgSubmitArgume
 	if err := msg.ValidateBasic(); err != nil {
 		return err.Result()
 	}
-	argument, err := keeper.SubmitArgument(ctx, msg.Body, msg.Summary, msg.Creator, msg.ClaimID, msg.StakeType)
+	var argument Argument
+	var err sdk.Error
+	if msg.ParentArgumentID != 0 {
+		argument, err = keeper.SubmitReply(ctx, msg.Body, msg.Summary, msg.Creator, msg.ClaimID, msg.ParentArgumentID, msg.StakeType)
+	} else {
+		argument, err = keeper.SubmitArgument(ctx, msg.Body, msg.Summary, msg.Creator, msg.ClaimID, msg.StakeType)
+	}
 	if err != nil {
 		return err.Result()
 	}
+	if msg.AutoRenew {
+		stake, ok := keeper.ArgumentCreationStake(ctx, argument.ID)
+		if !ok {
+			return ErrCodeUnknownStake(0).Result()
+		}
+		_, err = keeper.SetStakeAutoRenew(ctx, stake.ID, msg.Creator, true, false)
+		if err != nil {
+			return err.Result()
+		}
+	}
 	res, codecErr := ModuleCodec.MarshalJSON(argument)
 	if codecErr != nil {
 		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
@@ -55,6 +85,30 @@ func handleMsgSubmitUpvote(ctx sdk.Context, keeper Keeper, msg MsgSubmitUpvote)
 	if err != nil {
 		return err.Result()
 	}
+	if msg.AutoRenew {
+		stake, err = keeper.SetStakeAutoRenew(ctx, stake.ID, msg.Creator, true, false)
+		if err != nil {
+			return err.Result()
+		}
+	}
+	res, codecErr := ModuleCodec.MarshalJSON(stake)
+	if codecErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgSubmitDownvote(ctx sdk.Context, keeper Keeper, msg MsgSubmitDownvote) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+	stake, err := keeper.SubmitDownvote(ctx, msg.ArgumentID, msg.Creator)
+	if err != nil {
+		return err.Result()
+	}
 	res, codecErr := ModuleCodec.MarshalJSON(stake)
 	if codecErr != nil {
 		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
@@ -82,6 +136,57 @@ func handleMsgEditArgument(ctx sdk.Context, keeper Keeper, msg MsgEditArgument)
 	}
 }
 
+func handleMsgDeleteArgument(ctx sdk.Context, keeper Keeper, msg MsgDeleteArgument) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+	argument, err := keeper.DeleteArgument(ctx, msg.ArgumentID, msg.Creator)
+	if err != nil {
+		return err.Result()
+	}
+	res, codecErr := ModuleCodec.MarshalJSON(argument)
+	if codecErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
+	}
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgWithdrawStake(ctx sdk.Context, keeper Keeper, msg MsgWithdrawStake) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+	stake, err := keeper.WithdrawStake(ctx, msg.StakeID, msg.Creator)
+	if err != nil {
+		return err.Result()
+	}
+	res, codecErr := ModuleCodec.MarshalJSON(stake)
+	if codecErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
+	}
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgSetStakeAutoRenew(ctx sdk.Context, keeper Keeper, msg MsgSetStakeAutoRenew) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+	stake, err := keeper.SetStakeAutoRenew(ctx, msg.StakeID, msg.Creator, msg.AutoRenew, msg.Compound)
+	if err != nil {
+		return err.Result()
+	}
+	res, codecErr := ModuleCodec.MarshalJSON(stake)
+	if codecErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
+	}
+	return sdk.Result{
+		Data: res,
+	}
+}
+
 func handleMsgAddAdmin(ctx sdk.Context, k Keeper, msg MsgAddAdmin) sdk.Result {
 	if err := msg.ValidateBasic(); err != nil {
 		return err.Result()
@@ -143,3 +248,63 @@ func handleMsgUpdateParams(ctx sdk.Context, k Keeper, msg MsgUpdateParams) sdk.R
 		Data: res,
 	}
 }
+
+func handleMsgUpdateCommunityStakingParams(ctx sdk.Context, k Keeper, msg MsgUpdateCommunityStakingParams) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	err := k.UpdateCommunityStakingParams(ctx, msg.Updater, msg.CommunityID, msg.Updates, msg.UpdatedFields)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := ModuleCodec.MarshalJSON(k.CommunityParams(ctx, msg.CommunityID))
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgRemoveCommunityStakingParams(ctx sdk.Context, k Keeper, msg MsgRemoveCommunityStakingParams) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	err := k.RemoveCommunityStakingParams(ctx, msg.Remover, msg.CommunityID)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := ModuleCodec.MarshalJSON(true)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgRetryStakePayout(ctx sdk.Context, k Keeper, msg MsgRetryStakePayout) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	stake, err := k.RetryStakePayout(ctx, msg.StakeID, msg.Admin)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := ModuleCodec.MarshalJSON(stake)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
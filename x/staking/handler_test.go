@@ -49,6 +49,46 @@ func TestHandle_SubmitUpvote(t *testing.T) {
 
 }
 
+func TestHandle_SubmitReply(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	handler := NewHandler(k)
+	addr1 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+
+	res := handler(ctx, NewMsgSubmitArgument(addr1, 1, "summary 1", "body 1", StakeBacking))
+	assert.True(t, res.IsOK())
+
+	msg := NewMsgSubmitReply(addr2, 1, 1, "summary 2", "body 2", StakeChallenge)
+	assert.Equal(t, msg.Type(), TypeMsgSubmitArgument)
+	res = handler(ctx, msg)
+	assert.True(t, res.IsOK())
+	reply := Argument{}
+	ModuleCodec.MustUnmarshalJSON(res.Data, &reply)
+	assert.Equal(t, uint64(1), reply.ParentArgumentID)
+	assert.Equal(t, StakeChallenge, reply.StakeType)
+}
+
+func TestHandle_DeleteArgument(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	handler := NewHandler(k)
+	addr1 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+
+	res := handler(ctx, NewMsgSubmitArgument(addr1, 1, "summary 1", "body 1", StakeBacking))
+	assert.True(t, res.IsOK())
+
+	msg := NewMsgDeleteArgument(addr1, 1)
+	assert.Equal(t, msg.Route(), RouterKey)
+	assert.Equal(t, msg.Type(), TypeMsgDeleteArgument)
+	res = handler(ctx, msg)
+	assert.True(t, res.IsOK())
+
+	argument := Argument{}
+	err := ModuleCodec.UnmarshalJSON(res.Data, &argument)
+	assert.NoError(t, err)
+	assert.True(t, argument.Deleted)
+	assert.Equal(t, sdk.NewInt(app.Shanev*300), k.bankKeeper.GetCoins(ctx, addr1).AmountOf(app.StakeDenom))
+}
+
 func TestHandleMsgAddAdmin(t *testing.T) {
 	ctx, keeper, _ := mockDB()
 	handler := NewHandler(keeper)
@@ -83,6 +123,30 @@ func TestHandleMsgRemoveAdmin(t *testing.T) {
 	assert.Equal(t, success, true)
 }
 
+func TestHandleMsgUpdateCommunityStakingParams(t *testing.T) {
+	ctx, keeper, _ := mockDB()
+	handler := NewHandler(keeper)
+
+	updater := keeper.GetParams(ctx).StakingAdmins[0]
+	updates := CommunityStakingParams{CreatorShare: sdk.NewDecWithPrec(25, 2)}
+	msg := NewMsgUpdateCommunityStakingParams("crypto", updates, []string{"creator_share"}, updater)
+
+	result := handler(ctx, msg)
+	assert.True(t, result.IsOK())
+	var params Params
+	err := ModuleCodec.UnmarshalJSON(result.Data, &params)
+	assert.NoError(t, err)
+	assert.Equal(t, sdk.NewDecWithPrec(25, 2), params.CreatorShare)
+
+	removeMsg := NewMsgRemoveCommunityStakingParams("crypto", updater)
+	result = handler(ctx, removeMsg)
+	var success bool
+	err = json.Unmarshal(result.Data, &success)
+	assert.NoError(t, err)
+	assert.Equal(t, success, true)
+	assert.Equal(t, keeper.GetParams(ctx), keeper.CommunityParams(ctx, "crypto"))
+}
+
 func TestByzantineMsg(t *testing.T) {
 	ctx, k, _ := mockDB()
 
@@ -0,0 +1,126 @@
+package staking
+
+import (
+	"github.com/TruStory/truchain/x/claim"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StakingHooks lets other modules react to the lifecycle of arguments and stakes.
+// The creation stake of an argument is created before the argument itself.
+// Stakes withdrawn, refunded or slashed before their end time expire without a reward.
+type StakingHooks interface {
+	AfterArgumentCreated(ctx sdk.Context, argument Argument)
+	AfterArgumentEdited(ctx sdk.Context, argument Argument)
+	AfterStakeCreated(ctx sdk.Context, stake Stake)
+	AfterStakeExpired(ctx sdk.Context, stake Stake, result RewardResult)
+}
+
+// MultiStakingHooks combines multiple staking hooks, all hook functions are run in array sequence
+type MultiStakingHooks []StakingHooks
+
+var _ StakingHooks = MultiStakingHooks{}
+
+// NewMultiStakingHooks combines staking hooks
+func NewMultiStakingHooks(hooks ...StakingHooks) MultiStakingHooks {
+	return hooks
+}
+
+// AfterArgumentCreated implements StakingHooks
+func (h MultiStakingHooks) AfterArgumentCreated(ctx sdk.Context, argument Argument) {
+	for i := range h {
+		h[i].AfterArgumentCreated(ctx, argument)
+	}
+}
+
+// AfterArgumentEdited implements StakingHooks
+func (h MultiStakingHooks) AfterArgumentEdited(ctx sdk.Context, argument Argument) {
+	for i := range h {
+		h[i].AfterArgumentEdited(ctx, argument)
+	}
+}
+
+// AfterStakeCreated implements StakingHooks
+func (h MultiStakingHooks) AfterStakeCreated(ctx sdk.Context, stake Stake) {
+	for i := range h {
+		h[i].AfterStakeCreated(ctx, stake)
+	}
+}
+
+// AfterStakeExpired implements StakingHooks
+func (h MultiStakingHooks) AfterStakeExpired(ctx sdk.Context, stake Stake, result RewardResult) {
+	for i := range h {
+		h[i].AfterStakeExpired(ctx, stake, result)
+	}
+}
+
+// SetHooks sets the staking hooks, they can only be set once
+func (k *Keeper) SetHooks(sh StakingHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set staking hooks twice")
+	}
+	k.hooks = sh
+	return k
+}
+
+func (k Keeper) afterArgumentCreated(ctx sdk.Context, argument Argument) {
+	if k.hooks != nil {
+		k.hooks.AfterArgumentCreated(ctx, argument)
+	}
+}
+
+func (k Keeper) afterArgumentEdited(ctx sdk.Context, argument Argument) {
+	if k.hooks != nil {
+		k.hooks.AfterArgumentEdited(ctx, argument)
+	}
+}
+
+func (k Keeper) afterStakeCreated(ctx sdk.Context, stake Stake) {
+	if k.hooks != nil {
+		k.hooks.AfterStakeCreated(ctx, stake)
+	}
+}
+
+func (k Keeper) afterStakeExpired(ctx sdk.Context, stake Stake, result RewardResult) {
+	if k.hooks != nil {
+		k.hooks.AfterStakeExpired(ctx, stake, result)
+	}
+}
+
+// Hooks lets the staking module react to the lifecycle of claims
+type Hooks struct {
+	k Keeper
+}
+
+var _ claim.ClaimHooks = Hooks{}
+
+// Hooks returns the claim hooks of the staking module
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterClaimRemoved refunds every active stake on the arguments of a removed claim.
+// Nobody forfeits their stake, the arguments weren't at fault.
+func (h Hooks) AfterClaimRemoved(ctx sdk.Context, c claim.Claim) sdk.Error {
+	for _, argument := range h.k.ClaimArguments(ctx, c.ID) {
+		_, err := h.k.removeArgument(ctx, argument, false)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterClaimsMerged moves the arguments of a duplicate claim, with their active stakes, to the canonical claim
+func (h Hooks) AfterClaimsMerged(ctx sdk.Context, duplicate, canonical claim.Claim) sdk.Error {
+	arguments := h.k.ClaimArguments(ctx, duplicate.ID)
+	for _, argument := range arguments {
+		err := h.k.moveArgument(ctx, argument, canonical.ID)
+		if err != nil {
+			return err
+		}
+	}
+	if len(arguments) > 0 && canonical.FirstArgumentTime.IsZero() {
+		return h.k.claimKeeper.SetFirstArgumentTime(ctx, canonical.ID, ctx.BlockHeader().Time)
+	}
+	return nil
+}
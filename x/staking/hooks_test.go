@@ -0,0 +1,212 @@
+package staking
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	app "github.com/TruStory/truchain/types"
+	"github.com/TruStory/truchain/x/claim"
+)
+
+type recordingHooks struct {
+	createdArguments []uint64
+	editedArguments  []uint64
+	createdStakes    []uint64
+	expiredStakes    []uint64
+	results          []RewardResult
+}
+
+func (h *recordingHooks) AfterArgumentCreated(_ sdk.Context, argument Argument) {
+	h.createdArguments = append(h.createdArguments, argument.ID)
+}
+
+func (h *recordingHooks) AfterArgumentEdited(_ sdk.Context, argument Argument) {
+	h.editedArguments = append(h.editedArguments, argument.ID)
+}
+
+func (h *recordingHooks) AfterStakeCreated(_ sdk.Context, stake Stake) {
+	h.createdStakes = append(h.createdStakes, stake.ID)
+}
+
+func (h *recordingHooks) AfterStakeExpired(_ sdk.Context, stake Stake, result RewardResult) {
+	h.expiredStakes = append(h.expiredStakes, stake.ID)
+	h.results = append(h.results, result)
+}
+
+func TestKeeper_Hooks(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	first, second := &recordingHooks{}, &recordingHooks{}
+	k.SetHooks(NewMultiStakingHooks(first, second))
+	assert.Panics(t, func() { k.SetHooks(first) })
+
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	_, err = k.EditArgument(ctx, "new body", "new summary", addr, argument.ID)
+	assert.NoError(t, err)
+	upvote, err := k.SubmitUpvote(ctx, argument.ID, addr2)
+	assert.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(k.GetParams(ctx).Period).Add(time.Second))
+	EndBlocker(ctx, k)
+
+	for _, hooks := range []*recordingHooks{first, second} {
+		assert.Equal(t, []uint64{argument.ID}, hooks.createdArguments)
+		assert.Equal(t, []uint64{argument.ID}, hooks.editedArguments)
+		assert.Equal(t, []uint64{1, upvote.ID}, hooks.createdStakes)
+		assert.Equal(t, []uint64{1, upvote.ID}, hooks.expiredStakes)
+		assert.Equal(t, RewardResultArgumentCreation, hooks.results[0].Type)
+		assert.Equal(t, RewardResultUpvoteSplit, hooks.results[1].Type)
+	}
+}
+
+func TestKeeper_HooksStakesEndedEarly(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	hooks := &recordingHooks{}
+	k.SetHooks(hooks)
+	admin := k.GetParams(ctx).StakingAdmins[0]
+
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	withdrawn, err := k.SubmitUpvote(ctx, argument.ID, addr2)
+	assert.NoError(t, err)
+	_, err = k.WithdrawStake(ctx, withdrawn.ID, addr2)
+	assert.NoError(t, err)
+	addr3 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	refunded, err := k.SubmitUpvote(ctx, argument.ID, addr3)
+	assert.NoError(t, err)
+	_, err = k.DeleteArgument(ctx, argument.ID, admin)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []uint64{withdrawn.ID, 1, refunded.ID}, hooks.expiredStakes)
+	assert.Equal(t, RewardResultWithdrawn, hooks.results[0].Type)
+	assert.Equal(t, RewardResultSlashed, hooks.results[1].Type)
+	assert.Equal(t, RewardResultRefunded, hooks.results[2].Type)
+	for _, result := range hooks.results {
+		assert.True(t, result.StakeCreatorReward.IsZero())
+	}
+	for _, id := range hooks.expiredStakes {
+		stake, _ := k.Stake(ctx, id)
+		assert.True(t, stake.Expired)
+		assert.Nil(t, stake.Result)
+	}
+}
+
+func TestHooks_AfterClaimRemoved(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	mockedClaimKeeper.enableTrackStake = true
+	mockedClaimKeeper.SetClaims(map[uint64]claim.Claim{
+		1: {
+			ID:              1,
+			CommunityID:     "crypto",
+			TotalBacked:     sdk.NewInt64Coin(app.StakeDenom, 0),
+			TotalChallenged: sdk.NewInt64Coin(app.StakeDenom, 0),
+		},
+	})
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	challenge, err := k.SubmitArgument(ctx, "body", "summary", addr2, 1, StakeChallenge)
+	assert.NoError(t, err)
+	_, err = k.SubmitUpvote(ctx, argument.ID, addr2)
+	assert.NoError(t, err)
+
+	c, _ := mockedClaimKeeper.Claim(ctx, 1)
+	err = k.Hooks().AfterClaimRemoved(ctx, c)
+	assert.NoError(t, err)
+
+	// everybody gets their stake back
+	assert.Equal(t, sdk.NewInt(app.Shanev*300), k.bankKeeper.GetCoins(ctx, addr).AmountOf(app.StakeDenom))
+	assert.Equal(t, sdk.NewInt(app.Shanev*300), k.bankKeeper.GetCoins(ctx, addr2).AmountOf(app.StakeDenom))
+	assert.True(t, mdb.supplyKeeper.GetModuleAccount(ctx, UserStakesPoolName).GetCoins().IsZero())
+
+	for _, id := range []uint64{argument.ID, challenge.ID} {
+		removed, _ := k.Argument(ctx, id)
+		assert.True(t, removed.Deleted)
+		for _, stake := range k.ArgumentStakes(ctx, id) {
+			assert.True(t, stake.Expired)
+		}
+	}
+	assert.Len(t, k.ClaimArguments(ctx, 1), 0)
+	c, _ = mockedClaimKeeper.Claim(ctx, 1)
+	assert.True(t, c.TotalBacked.IsZero())
+	assert.True(t, c.TotalChallenged.IsZero())
+
+	_, stop := AllInvariants(k)(ctx)
+	assert.False(t, stop)
+}
+
+func TestHooks_AfterClaimsMerged(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	mockedClaimKeeper.enableTrackStake = true
+	mockedClaimKeeper.SetClaims(map[uint64]claim.Claim{
+		1: {
+			ID:              1,
+			CommunityID:     "crypto",
+			TotalBacked:     sdk.NewInt64Coin(app.StakeDenom, 0),
+			TotalChallenged: sdk.NewInt64Coin(app.StakeDenom, 0),
+		},
+		2: {
+			ID:              2,
+			CommunityID:     "crypto",
+			TotalBacked:     sdk.NewInt64Coin(app.StakeDenom, 0),
+			TotalChallenged: sdk.NewInt64Coin(app.StakeDenom, 0),
+		},
+	})
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	// expired stakes stay in the claim totals
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(k.GetParams(ctx).Period).Add(time.Second))
+	EndBlocker(ctx, k)
+	creationStake, _ := k.Stake(ctx, 1)
+	assert.True(t, creationStake.Expired)
+
+	challenge, err := k.SubmitArgument(ctx, "body", "summary", addr2, 1, StakeChallenge)
+	assert.NoError(t, err)
+	_, err = k.SubmitUpvote(ctx, argument.ID, addr2)
+	assert.NoError(t, err)
+
+	duplicate, _ := mockedClaimKeeper.Claim(ctx, 1)
+	canonical, _ := mockedClaimKeeper.Claim(ctx, 2)
+	err = k.Hooks().AfterClaimsMerged(ctx, duplicate, canonical)
+	assert.NoError(t, err)
+
+	assert.Len(t, k.ClaimArguments(ctx, 1), 0)
+	assert.Len(t, k.ClaimArguments(ctx, 2), 2)
+	for _, id := range []uint64{argument.ID, challenge.ID} {
+		moved, _ := k.Argument(ctx, id)
+		assert.Equal(t, uint64(2), moved.ClaimID)
+		assert.False(t, moved.Deleted)
+	}
+
+	// the stakes stay active and count towards the canonical claim
+	duplicate, _ = mockedClaimKeeper.Claim(ctx, 1)
+	assert.True(t, duplicate.TotalBacked.IsZero())
+	assert.True(t, duplicate.TotalChallenged.IsZero())
+	canonical, _ = mockedClaimKeeper.Claim(ctx, 2)
+	assert.Equal(t, sdk.NewInt(app.Shanev*60), canonical.TotalBacked.Amount)
+	assert.Equal(t, sdk.NewInt(app.Shanev*50), canonical.TotalChallenged.Amount)
+	assert.Equal(t, ctx.BlockHeader().Time, canonical.FirstArgumentTime)
+
+	_, stop := AllInvariants(k)(ctx)
+	assert.False(t, stop)
+
+	// a failed move is returned to the transaction instead of halting the chain
+	err = k.Hooks().AfterClaimsMerged(ctx, canonical, claim.Claim{ID: 99, CommunityID: "crypto"})
+	assert.Error(t, err)
+}
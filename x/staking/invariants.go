@@ -0,0 +1,193 @@
+package staking
+
+import (
+	"bytes"
+	"fmt"
+
+	app "github.com/TruStory/truchain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all staking invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(ModuleName, "stakes-pool", StakesPoolInvariant(k))
+	ir.RegisterRoute(ModuleName, "active-stake-queue", ActiveStakeQueueInvariant(k))
+	ir.RegisterRoute(ModuleName, "argument-stakes", ArgumentStakesInvariant(k))
+	ir.RegisterRoute(ModuleName, "claim-stakes", ClaimStakesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the staking module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			StakesPoolInvariant(k),
+			ActiveStakeQueueInvariant(k),
+			ArgumentStakesInvariant(k),
+			ClaimStakesInvariant(k),
+		} {
+			res, stop := invariant(ctx)
+			if stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// StakesPoolInvariant checks that the user stakes pool holds the amount of every unexpired stake
+func StakesPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		staked := sdk.ZeroInt()
+		for _, stake := range k.Stakes(ctx) {
+			if !stake.Expired {
+				staked = staked.Add(stake.Amount.Amount)
+			}
+		}
+		pool := k.supplyKeeper.GetModuleAccount(ctx, UserStakesPoolName).GetCoins().AmountOf(app.StakeDenom)
+		broken := !pool.Equal(staked)
+		return sdk.FormatInvariant(ModuleName, "stakes-pool", fmt.Sprintf(
+			"\tPool balance: %s\n\tSum of unexpired stakes: %s\n", pool, staked)), broken
+	}
+}
+
+// ActiveStakeQueueInvariant checks that the active stake queue holds every unexpired stake at its end time.
+// Unexpired stakes whose payout failed wait in the failed payouts queue instead.
+func ActiveStakeQueueInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+		queued := make(map[uint64]bool)
+		iterator := sdk.KVStorePrefixIterator(k.store(ctx), ActiveStakeQueuePrefix)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			var stakeID uint64
+			k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &stakeID)
+			stake, ok := k.Stake(ctx, stakeID)
+			switch {
+			case !ok:
+				msg += fmt.Sprintf("\tunknown stake %d is queued\n", stakeID)
+			case stake.Expired:
+				msg += fmt.Sprintf("\texpired stake %d is queued\n", stakeID)
+			case queued[stakeID]:
+				msg += fmt.Sprintf("\tstake %d is queued more than once\n", stakeID)
+			case !bytes.Equal(iterator.Key(), activeStakeQueueKey(stake.ID, stake.EndTime)):
+				msg += fmt.Sprintf("\tstake %d is queued at the wrong end time\n", stakeID)
+			default:
+				queued[stakeID] = true
+				continue
+			}
+			count++
+		}
+		for _, stake := range k.Stakes(ctx) {
+			if stake.Expired || queued[stake.ID] {
+				continue
+			}
+			if _, ok := k.FailedStakePayout(ctx, stake.ID); ok {
+				continue
+			}
+			count++
+			msg += fmt.Sprintf("\tunexpired stake %d is not queued\n", stake.ID)
+		}
+		broken := count != 0
+		return sdk.FormatInvariant(ModuleName, "active-stake-queue", fmt.Sprintf(
+			"%d queue entries don't match the unexpired stakes\n%s", count, msg)), broken
+	}
+}
+
+// ArgumentStakesInvariant checks that the stake totals of every argument add up to the stakes on it.
+// Withdrawn stakes left the argument, downvotes only count towards the downvoted stake.
+func ArgumentStakesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+		for _, argument := range k.Arguments(ctx) {
+			total, upvoted, downvoted := sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()
+			for _, stake := range k.ArgumentStakes(ctx, argument.ID) {
+				switch {
+				case stake.Withdrawn:
+				case stake.Type == StakeDownvote:
+					downvoted = downvoted.Add(stake.Amount.Amount)
+				case stake.Type == StakeUpvote:
+					upvoted = upvoted.Add(stake.Amount.Amount)
+					total = total.Add(stake.Amount.Amount)
+				default:
+					total = total.Add(stake.Amount.Amount)
+				}
+			}
+			if !coinAmount(argument.TotalStake).Equal(total) ||
+				!coinAmount(argument.UpvotedStake).Equal(upvoted) ||
+				!coinAmount(argument.DownvotedStake).Equal(downvoted) {
+				count++
+				msg += fmt.Sprintf("\targument %d totals %s/%s/%s, stakes sum to %s/%s/%s\n", argument.ID,
+					coinAmount(argument.TotalStake), coinAmount(argument.UpvotedStake), coinAmount(argument.DownvotedStake),
+					total, upvoted, downvoted)
+			}
+		}
+		broken := count != 0
+		return sdk.FormatInvariant(ModuleName, "argument-stakes", fmt.Sprintf(
+			"%d arguments have totals that don't match their stakes (total/upvoted/downvoted)\n%s", count, msg)), broken
+	}
+}
+
+// ClaimStakesInvariant checks that the backing and challenge totals of every argued claim add up to its arguments' stakes
+func ClaimStakesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		backed := make(map[uint64]sdk.Int)
+		challenged := make(map[uint64]sdk.Int)
+		claimIDs := make([]uint64, 0)
+		for _, argument := range k.Arguments(ctx) {
+			if _, ok := backed[argument.ClaimID]; !ok {
+				backed[argument.ClaimID], challenged[argument.ClaimID] = sdk.ZeroInt(), sdk.ZeroInt()
+				claimIDs = append(claimIDs, argument.ClaimID)
+			}
+			for _, stake := range k.ArgumentStakes(ctx, argument.ID) {
+				if !countsTowardsClaim(argument, stake) {
+					continue
+				}
+				switch argument.StakeType {
+				case StakeBacking:
+					backed[argument.ClaimID] = backed[argument.ClaimID].Add(stake.Amount.Amount)
+				case StakeChallenge:
+					challenged[argument.ClaimID] = challenged[argument.ClaimID].Add(stake.Amount.Amount)
+				}
+			}
+		}
+
+		var msg string
+		count := 0
+		for _, claimID := range claimIDs {
+			claim, ok := k.claimKeeper.Claim(ctx, claimID)
+			if !ok {
+				count++
+				msg += fmt.Sprintf("\targuments are on unknown claim %d\n", claimID)
+				continue
+			}
+			if !coinAmount(claim.TotalBacked).Equal(backed[claimID]) ||
+				!coinAmount(claim.TotalChallenged).Equal(challenged[claimID]) {
+				count++
+				msg += fmt.Sprintf("\tclaim %d totals %s/%s, argument stakes sum to %s/%s\n", claimID,
+					coinAmount(claim.TotalBacked), coinAmount(claim.TotalChallenged), backed[claimID], challenged[claimID])
+			}
+		}
+		broken := count != 0
+		return sdk.FormatInvariant(ModuleName, "claim-stakes", fmt.Sprintf(
+			"%d claims have totals that don't match their arguments' stakes (backed/challenged)\n%s", count, msg)), broken
+	}
+}
+
+// countsTowardsClaim returns true if a stake is part of its claim's totals.
+// Slashing an argument takes its creation stake out of the claim totals, its upvotes stay in.
+// Deleting an argument takes the stakes that were still active out of the claim totals,
+// those are left without a payout result.
+func countsTowardsClaim(argument Argument, stake Stake) bool {
+	if stake.Type == StakeDownvote || stake.Withdrawn {
+		return false
+	}
+	if argument.IsUnhelpful && stake.Type != StakeUpvote {
+		return false
+	}
+	if argument.Deleted && !argument.IsUnhelpful {
+		return stake.Result != nil
+	}
+	return true
+}
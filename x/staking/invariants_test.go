@@ -0,0 +1,112 @@
+package staking
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	app "github.com/TruStory/truchain/types"
+	"github.com/TruStory/truchain/x/claim"
+)
+
+func TestInvariants(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(mustParseTime("2019-01-01"))
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	mockedClaimKeeper.enableTrackStake = true
+	mockedClaimKeeper.SetClaims(map[uint64]claim.Claim{
+		1: {
+			ID:              1,
+			CommunityID:     "crypto",
+			TotalBacked:     sdk.NewInt64Coin(app.StakeDenom, 0),
+			TotalChallenged: sdk.NewInt64Coin(app.StakeDenom, 0),
+		},
+	})
+	funds := sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)}
+	addr1 := createFakeFundedAccount(ctx, mdb.authAccKeeper, funds)
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, funds)
+	addr3 := createFakeFundedAccount(ctx, mdb.authAccKeeper, funds)
+	admin := k.GetParams(ctx).StakingAdmins[0]
+
+	assertUnbroken := func() {
+		msg, broken := AllInvariants(k)(ctx)
+		assert.False(t, broken, msg)
+	}
+
+	backing, err := k.SubmitArgument(ctx, "backing body", "summary", addr1, 1, StakeBacking)
+	assert.NoError(t, err)
+	challenge, err := k.SubmitArgument(ctx, "challenge body", "summary", addr2, 1, StakeChallenge)
+	assert.NoError(t, err)
+	_, err = k.SubmitUpvote(ctx, backing.ID, addr2)
+	assert.NoError(t, err)
+	_, err = k.SubmitDownvote(ctx, backing.ID, addr3)
+	assert.NoError(t, err)
+	withdrawn, err := k.SubmitUpvote(ctx, challenge.ID, addr1)
+	assert.NoError(t, err)
+	_, err = k.SubmitUpvote(ctx, challenge.ID, addr3)
+	assert.NoError(t, err)
+	assertUnbroken()
+
+	_, err = k.WithdrawStake(ctx, withdrawn.ID, addr1)
+	assert.NoError(t, err)
+	assertUnbroken()
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(k.GetParams(ctx).Period).Add(time.Second))
+	EndBlocker(ctx, k)
+	assertUnbroken()
+
+	// a renewed stake keeps the pool, the queue and the totals in line
+	late, err := k.SubmitArgument(ctx, "late backing body", "summary", addr3, 1, StakeBacking)
+	assert.NoError(t, err)
+	lateStake, _ := k.ArgumentCreationStake(ctx, late.ID)
+	_, err = k.SetStakeAutoRenew(ctx, lateStake.ID, addr3, true, false)
+	assert.NoError(t, err)
+	_, err = k.SubmitUpvote(ctx, late.ID, addr1)
+	assert.NoError(t, err)
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(k.GetParams(ctx).Period).Add(time.Second))
+	EndBlocker(ctx, k)
+	assertUnbroken()
+
+	_, err = k.SubmitUpvote(ctx, late.ID, addr2)
+	assert.NoError(t, err)
+	_, err = k.DeleteArgument(ctx, late.ID, admin)
+	assert.NoError(t, err)
+	assertUnbroken()
+
+	// drift is caught
+	argument, _ := k.Argument(ctx, backing.ID)
+	argument.TotalStake = argument.TotalStake.Add(sdk.NewInt64Coin(app.StakeDenom, 1))
+	k.setArgument(ctx, argument)
+	_, broken := ArgumentStakesInvariant(k)(ctx)
+	assert.True(t, broken)
+	_, broken = ClaimStakesInvariant(k)(ctx)
+	assert.False(t, broken)
+	argument.TotalStake = argument.TotalStake.Sub(sdk.NewInt64Coin(app.StakeDenom, 1))
+	k.setArgument(ctx, argument)
+
+	assert.NoError(t, mockedClaimKeeper.AddChallengeStake(ctx, 1, sdk.NewInt64Coin(app.StakeDenom, 1)))
+	_, broken = ClaimStakesInvariant(k)(ctx)
+	assert.True(t, broken)
+	assert.NoError(t, mockedClaimKeeper.SubtractChallengeStake(ctx, 1, sdk.NewInt64Coin(app.StakeDenom, 1)))
+
+	addr4 := createFakeFundedAccount(ctx, mdb.authAccKeeper, funds)
+	stake, err := k.SubmitUpvote(ctx, backing.ID, addr4)
+	assert.NoError(t, err)
+	assertUnbroken()
+	k.RemoveFromActiveStakeQueue(ctx, stake.ID, stake.EndTime)
+	_, broken = ActiveStakeQueueInvariant(k)(ctx)
+	assert.True(t, broken)
+	k.InsertActiveStakeQueue(ctx, stake.ID, stake.EndTime.Add(time.Hour))
+	_, broken = ActiveStakeQueueInvariant(k)(ctx)
+	assert.True(t, broken)
+	k.RemoveFromActiveStakeQueue(ctx, stake.ID, stake.EndTime.Add(time.Hour))
+	k.InsertActiveStakeQueue(ctx, stake.ID, stake.EndTime)
+	assertUnbroken()
+
+	err = mdb.supplyKeeper.MintCoins(ctx, UserStakesPoolName, sdk.NewCoins(sdk.NewInt64Coin(app.StakeDenom, 1)))
+	assert.NoError(t, err)
+	_, broken = StakesPoolInvariant(k)(ctx)
+	assert.True(t, broken)
+}
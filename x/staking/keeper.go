@@ -1,9 +1,11 @@
 package staking
 
 import (
+	"fmt"
 	"time"
 
 	app "github.com/TruStory/truchain/types"
+	"github.com/TruStory/truchain/x/account"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/gaskv"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -22,6 +24,7 @@ type Keeper struct {
 	accountKeeper AccountKeeper
 	claimKeeper   ClaimKeeper
 	supplyKeeper  supply.Keeper
+	hooks         StakingHooks
 }
 
 // NewKeeper creates a staking keeper.
@@ -74,6 +77,16 @@ func (k Keeper) ClaimArguments(ctx sdk.Context, claimID uint64) []Argument {
 	return arguments
 }
 
+// ArgumentReplies returns the direct replies to an argument
+func (k Keeper) ArgumentReplies(ctx sdk.Context, argumentID uint64) []Argument {
+	arguments := make([]Argument, 0)
+	k.IterateArgumentReplies(ctx, argumentID, func(argument Argument) bool {
+		arguments = append(arguments, argument)
+		return false
+	})
+	return arguments
+}
+
 func (k Keeper) ArgumentStakes(ctx sdk.Context, argumentID uint64) []Stake {
 	stakes := make([]Stake, 0)
 	k.IterateArgumentStakes(ctx, argumentID, func(stake Stake) bool {
@@ -128,6 +141,9 @@ func (k Keeper) SubmitUpvote(ctx sdk.Context, argumentID uint64, creator sdk.Acc
 	if !ok {
 		return Stake{}, ErrCodeUnknownArgument(argumentID)
 	}
+	if argument.Deleted {
+		return Stake{}, ErrCodeArgumentDeleted(argumentID)
+	}
 	stakes := k.ArgumentStakes(ctx, argumentID)
 	for _, s := range stakes {
 		if s.Creator.Equals(creator) {
@@ -138,9 +154,13 @@ func (k Keeper) SubmitUpvote(ctx sdk.Context, argumentID uint64, creator sdk.Acc
 	if !ok {
 		return Stake{}, ErrCodeUnknownClaim(argument.ClaimID)
 	}
+	if !claim.AcceptsStakes() {
+		return Stake{}, ErrCodeClaimClosed(claim)
+	}
 
-	upvoteStake := k.GetParams(ctx).UpvoteStake
-	stake, err := k.newStake(ctx, upvoteStake, creator, StakeUpvote, argumentID, claim.CommunityID)
+	upvoteStake := k.CommunityParams(ctx, claim.CommunityID).UpvoteStake
+	interestRate := k.stakeInterestRate(ctx, claim, argument.StakeType)
+	stake, err := k.newStake(ctx, upvoteStake, creator, StakeUpvote, argumentID, claim.CommunityID, interestRate)
 	if err != nil {
 		return stake, err
 	}
@@ -163,9 +183,130 @@ func (k Keeper) SubmitUpvote(ctx sdk.Context, argumentID uint64, creator sdk.Acc
 		}
 	}
 
+	err = k.countUnjailUpvote(ctx, argument.Creator)
+	if err != nil {
+		return Stake{}, err
+	}
+
 	return stake, nil
 }
 
+// SubmitDownvote puts stake behind disagreeing with an argument.
+// Downvotes don't count towards the claim totals, they earn no interest and
+// only pay out a share of the slashed funds when the argument is marked unhelpful.
+func (k Keeper) SubmitDownvote(ctx sdk.Context, argumentID uint64, creator sdk.AccAddress) (Stake, sdk.Error) {
+	err := k.checkJailed(ctx, creator)
+	if err != nil {
+		return Stake{}, err
+	}
+	argument, ok := k.Argument(ctx, argumentID)
+	if !ok {
+		return Stake{}, ErrCodeUnknownArgument(argumentID)
+	}
+	if argument.Deleted {
+		return Stake{}, ErrCodeArgumentDeleted(argumentID)
+	}
+	stakes := k.ArgumentStakes(ctx, argumentID)
+	for _, s := range stakes {
+		if s.Creator.Equals(creator) {
+			return Stake{}, ErrCodeDuplicateStake(argumentID)
+		}
+	}
+	claim, ok := k.claimKeeper.Claim(ctx, argument.ClaimID)
+	if !ok {
+		return Stake{}, ErrCodeUnknownClaim(argument.ClaimID)
+	}
+	if !claim.AcceptsStakes() {
+		return Stake{}, ErrCodeClaimClosed(claim)
+	}
+
+	downvoteStake := k.CommunityParams(ctx, claim.CommunityID).UpvoteStake
+	stake, err := k.newStake(ctx, downvoteStake, creator, StakeDownvote, argumentID, claim.CommunityID, sdk.ZeroDec())
+	if err != nil {
+		return stake, err
+	}
+	// arguments created before downvotes existed have no downvoted stake yet
+	if argument.DownvotedStake.Denom == "" {
+		argument.DownvotedStake = sdk.NewInt64Coin(app.StakeDenom, 0)
+	}
+	argument.DownvotedCount = argument.DownvotedCount + 1
+	argument.DownvotedStake = argument.DownvotedStake.Add(stake.Amount)
+	argument.UpdatedTime = ctx.BlockHeader().Time
+	k.setArgument(ctx, argument)
+
+	return stake, nil
+}
+
+// countUnjailUpvote counts an upvote received by a jailed user,
+// and unjails them early once they reach the UnjailUpvotes param
+func (k Keeper) countUnjailUpvote(ctx sdk.Context, address sdk.AccAddress) sdk.Error {
+	required := k.GetParams(ctx).UnjailUpvotes
+	if required <= 0 {
+		return nil
+	}
+	acc, err := k.accountKeeper.PrimaryAccount(ctx, address)
+	if err != nil {
+		return err
+	}
+	if !acc.IsJailed {
+		return nil
+	}
+
+	progress, ok := k.unjailUpvotes(ctx, address)
+	// upvotes from a previous jail term don't count
+	if !ok || !progress.JailEndTime.Equal(acc.JailEndTime) {
+		progress = UnjailUpvotes{Address: address, JailEndTime: acc.JailEndTime}
+	}
+	progress.Upvotes++
+	if progress.Upvotes < required {
+		k.setUnjailUpvotes(ctx, progress)
+		return nil
+	}
+
+	k.store(ctx).Delete(unjailUpvotesKey(address))
+	err = k.accountKeeper.UnJail(ctx, address)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			account.EventTypeUnjailedAccount,
+			sdk.NewAttribute(account.AttributeKeyUser, address.String()),
+			sdk.NewAttribute(account.AttributeKeyReason, account.AttributeValueReasonUpvotes),
+		),
+	)
+	k.Logger(ctx).Info(fmt.Sprintf("Unjailed %s after %d upvotes", address.String(), progress.Upvotes))
+
+	return nil
+}
+
+func (k Keeper) unjailUpvotes(ctx sdk.Context, address sdk.AccAddress) (UnjailUpvotes, bool) {
+	progress := UnjailUpvotes{}
+	bz := k.store(ctx).Get(unjailUpvotesKey(address))
+	if bz == nil {
+		return progress, false
+	}
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &progress)
+	return progress, true
+}
+
+func (k Keeper) setUnjailUpvotes(ctx sdk.Context, progress UnjailUpvotes) {
+	k.store(ctx).Set(unjailUpvotesKey(progress.Address), k.codec.MustMarshalBinaryLengthPrefixed(progress))
+}
+
+// AllUnjailUpvotes returns the unjail progress of every jailed user that was upvoted
+func (k Keeper) AllUnjailUpvotes(ctx sdk.Context) []UnjailUpvotes {
+	all := make([]UnjailUpvotes, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), UnjailUpvotesKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var progress UnjailUpvotes
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &progress)
+		all = append(all, progress)
+	}
+	return all
+}
+
 func (k Keeper) checkJailed(ctx sdk.Context, address sdk.AccAddress) sdk.Error {
 	jailed, err := k.accountKeeper.IsJailed(ctx, address)
 	if err != nil {
@@ -179,6 +320,49 @@ func (k Keeper) checkJailed(ctx sdk.Context, address sdk.AccAddress) sdk.Error {
 
 func (k Keeper) SubmitArgument(ctx sdk.Context, body, summary string,
 	creator sdk.AccAddress, claimID uint64, stakeType StakeType) (Argument, sdk.Error) {
+	return k.submitArgument(ctx, body, summary, creator, claimID, stakeType, 0)
+}
+
+// SubmitReply submits an argument rebutting another argument of the same claim.
+// Replies take the opposite side of their parent, so their stakes count towards the other claim total.
+func (k Keeper) SubmitReply(ctx sdk.Context, body, summary string,
+	creator sdk.AccAddress, claimID, parentArgumentID uint64, stakeType StakeType) (Argument, sdk.Error) {
+	parent, ok := k.Argument(ctx, parentArgumentID)
+	if !ok {
+		return Argument{}, ErrCodeUnknownArgument(parentArgumentID)
+	}
+	if parent.Deleted {
+		return Argument{}, ErrCodeArgumentDeleted(parentArgumentID)
+	}
+	if parent.ClaimID != claimID {
+		return Argument{}, ErrCodeInvalidParentArgument(parentArgumentID, claimID)
+	}
+	if stakeType != parent.StakeType.Opposite() {
+		return Argument{}, ErrCodeInvalidStakeType(stakeType)
+	}
+	maxDepth := k.GetParams(ctx).MaxArgumentDepth
+	if k.argumentDepth(ctx, parent)+1 > maxDepth {
+		return Argument{}, ErrCodeMaxArgumentDepthReached(maxDepth)
+	}
+	return k.submitArgument(ctx, body, summary, creator, claimID, stakeType, parentArgumentID)
+}
+
+// argumentDepth counts the replies between an argument and the top level argument of its thread
+func (k Keeper) argumentDepth(ctx sdk.Context, argument Argument) int {
+	depth := 0
+	for argument.ParentArgumentID != 0 {
+		parent, ok := k.Argument(ctx, argument.ParentArgumentID)
+		if !ok {
+			break
+		}
+		argument = parent
+		depth++
+	}
+	return depth
+}
+
+func (k Keeper) submitArgument(ctx sdk.Context, body, summary string,
+	creator sdk.AccAddress, claimID uint64, stakeType StakeType, parentArgumentID uint64) (Argument, sdk.Error) {
 	// only backing or challenge
 	if !stakeType.ValidForArgument() {
 		return Argument{}, ErrCodeInvalidStakeType(stakeType)
@@ -191,6 +375,9 @@ func (k Keeper) SubmitArgument(ctx sdk.Context, body, summary string,
 	if !ok {
 		return Argument{}, ErrCodeUnknownClaim(claimID)
 	}
+	if !claim.AcceptsArguments() {
+		return Argument{}, ErrCodeClaimClosed(claim)
+	}
 
 	arguments := k.ClaimArguments(ctx, claimID)
 	count := 0
@@ -204,35 +391,42 @@ func (k Keeper) SubmitArgument(ctx sdk.Context, body, summary string,
 		return Argument{}, ErrCodeMaxNumOfArgumentsReached(p.MaxArgumentsPerClaim)
 	}
 
-	creationAmount := p.ArgumentCreationStake
+	creationAmount := k.CommunityParams(ctx, claim.CommunityID).ArgumentCreationStake
 	argumentID, err := k.argumentID(ctx)
 	if err != nil {
 		return Argument{}, err
 	}
 	argument := Argument{
-		ID:           argumentID,
-		Creator:      creator,
-		ClaimID:      claimID,
-		CommunityID:  claim.CommunityID,
-		Summary:      summary,
-		Body:         body,
-		StakeType:    stakeType,
-		CreatedTime:  ctx.BlockHeader().Time,
-		UpdatedTime:  ctx.BlockHeader().Time,
-		UpvotedStake: sdk.NewInt64Coin(app.StakeDenom, 0),
-		TotalStake:   creationAmount,
-		EditedTime:   ctx.BlockHeader().Time,
-		Edited:       false,
+		ID:               argumentID,
+		Creator:          creator,
+		ClaimID:          claimID,
+		CommunityID:      claim.CommunityID,
+		ParentArgumentID: parentArgumentID,
+		Summary:          summary,
+		Body:             body,
+		StakeType:        stakeType,
+		CreatedTime:      ctx.BlockHeader().Time,
+		UpdatedTime:      ctx.BlockHeader().Time,
+		UpvotedStake:     sdk.NewInt64Coin(app.StakeDenom, 0),
+		DownvotedStake:   sdk.NewInt64Coin(app.StakeDenom, 0),
+		TotalStake:       creationAmount,
+		EditedTime:       ctx.BlockHeader().Time,
+		Edited:           false,
 	}
-	_, err = k.newStake(ctx, creationAmount, creator, stakeType, argument.ID, claim.CommunityID)
+	interestRate := k.stakeInterestRate(ctx, claim, stakeType)
+	_, err = k.newStake(ctx, creationAmount, creator, stakeType, argument.ID, claim.CommunityID, interestRate)
 	if err != nil {
 		return Argument{}, err
 	}
 
 	k.setArgument(ctx, argument)
 	k.setArgumentID(ctx, argumentID+1)
+	k.setArgumentRevision(ctx, newArgumentRevision(argument, 0, creator, argument.CreatedTime))
 	k.setClaimArgument(ctx, claimID, argument.ID)
 	k.setUserArgument(ctx, creator, argument.ID)
+	if parentArgumentID != 0 {
+		k.setArgumentReply(ctx, parentArgumentID, argument.ID)
+	}
 
 	if claim.FirstArgumentTime.Equal(time.Time{}) {
 		err = k.claimKeeper.SetFirstArgumentTime(ctx, claimID, ctx.BlockHeader().Time)
@@ -254,6 +448,7 @@ func (k Keeper) SubmitArgument(ctx sdk.Context, body, summary string,
 		}
 	}
 
+	k.afterArgumentCreated(ctx, argument)
 	return argument, nil
 }
 
@@ -288,14 +483,223 @@ func (k Keeper) DownvoteArgument(ctx sdk.Context, argumentID uint64) sdk.Error {
 	return nil
 }
 
-func (k Keeper) SetStakeExpired(ctx sdk.Context, stakeID uint64) sdk.Error {
+// DeleteArgument lets a creator delete an argument as long it hasn't been staked on.
+// Admins can delete any argument, in which case the creator's stake is forfeited.
+// Every active stake is taken out of the queue and removed from the claim totals.
+func (k Keeper) DeleteArgument(ctx sdk.Context, argumentID uint64, deleter sdk.AccAddress) (Argument, sdk.Error) {
+	err := k.checkJailed(ctx, deleter)
+	if err != nil {
+		return Argument{}, err
+	}
+
+	argument, ok := k.Argument(ctx, argumentID)
+	if !ok {
+		return Argument{}, ErrCodeUnknownArgument(argumentID)
+	}
+	if argument.Deleted {
+		return Argument{}, ErrCodeArgumentDeleted(argumentID)
+	}
+
+	isAdmin := k.isAdmin(ctx, deleter)
+	isCreator := argument.Creator.Equals(deleter)
+	if !isCreator && !isAdmin {
+		return Argument{}, ErrCodeCannotDeleteArgumentWrongCreator(argumentID)
+	}
+
+	if len(k.ArgumentStakes(ctx, argumentID)) > 1 && !isAdmin {
+		return Argument{}, ErrCodeCannotDeleteArgumentAlreadyStaked(argumentID)
+	}
+
+	// creators don't get their stake back when an admin removes their argument
+	return k.removeArgument(ctx, argument, !isCreator)
+}
+
+// removeArgument refunds the active stakes of an argument, takes them out of the queue
+// and the claim totals, and marks the argument as deleted
+func (k Keeper) removeArgument(ctx sdk.Context, argument Argument, forfeitCreatorStake bool) (Argument, sdk.Error) {
+	var err sdk.Error
+	for _, stake := range k.ArgumentStakes(ctx, argument.ID) {
+		if stake.Expired {
+			continue
+		}
+		resultType := RewardResultRefunded
+		if forfeitCreatorStake && stake.Creator.Equals(argument.Creator) {
+			err = k.forfeitStake(ctx, stake, argument.CommunityID)
+			resultType = RewardResultSlashed
+		} else {
+			err = k.refundStake(ctx, stake, argument.CommunityID)
+		}
+		if err != nil {
+			return Argument{}, err
+		}
+		k.endStake(ctx, stake, resultType)
+
+		switch {
+		case stake.Type == StakeDownvote:
+			// downvotes don't count towards the claim totals
+		case argument.StakeType == StakeBacking:
+			err = k.claimKeeper.SubtractBackingStake(ctx, argument.ClaimID, stake.Amount)
+		case argument.StakeType == StakeChallenge:
+			err = k.claimKeeper.SubtractChallengeStake(ctx, argument.ClaimID, stake.Amount)
+		}
+		if err != nil {
+			return Argument{}, err
+		}
+	}
+
+	argument.Deleted = true
+	argument.DeletedTime = ctx.BlockHeader().Time
+	argument.UpdatedTime = ctx.BlockHeader().Time
+	k.setArgument(ctx, argument)
+	k.deleteClaimArgument(ctx, argument.ClaimID, argument.ID)
+	k.deleteUserArgument(ctx, argument.Creator, argument.ID)
+	if argument.ParentArgumentID != 0 {
+		k.deleteArgumentReply(ctx, argument.ParentArgumentID, argument.ID)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeArgumentDeleted,
+			sdk.NewAttribute(AttributeKeyArgumentID, fmt.Sprintf("%d", argument.ID)),
+			sdk.NewAttribute(AttributeKeyClaimID, fmt.Sprintf("%d", argument.ClaimID)),
+		),
+	)
+
+	return argument, nil
+}
+
+// moveArgument moves an argument and its share of the claim totals to another claim.
+// Expired stakes stay in the cumulative totals, so they move as well.
+func (k Keeper) moveArgument(ctx sdk.Context, argument Argument, claimID uint64) sdk.Error {
+	var err sdk.Error
+	for _, stake := range k.ArgumentStakes(ctx, argument.ID) {
+		if !countsTowardsClaim(argument, stake) {
+			continue
+		}
+		switch argument.StakeType {
+		case StakeBacking:
+			err = k.claimKeeper.SubtractBackingStake(ctx, argument.ClaimID, stake.Amount)
+			if err == nil {
+				err = k.claimKeeper.AddBackingStake(ctx, claimID, stake.Amount)
+			}
+		case StakeChallenge:
+			err = k.claimKeeper.SubtractChallengeStake(ctx, argument.ClaimID, stake.Amount)
+			if err == nil {
+				err = k.claimKeeper.AddChallengeStake(ctx, claimID, stake.Amount)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	k.deleteClaimArgument(ctx, argument.ClaimID, argument.ID)
+	argument.ClaimID = claimID
+	argument.UpdatedTime = ctx.BlockHeader().Time
+	k.setArgument(ctx, argument)
+	k.setClaimArgument(ctx, claimID, argument.ID)
+
+	return nil
+}
+
+// WithdrawStake lets a stake creator exit an active stake before it expires.
+// The early withdrawal penalty is forfeited to the user reward pool and no interest is paid.
+func (k Keeper) WithdrawStake(ctx sdk.Context, stakeID uint64, creator sdk.AccAddress) (Stake, sdk.Error) {
+	err := k.checkJailed(ctx, creator)
+	if err != nil {
+		return Stake{}, err
+	}
+	stake, ok := k.Stake(ctx, stakeID)
+	if !ok {
+		return Stake{}, ErrCodeUnknownStake(stakeID)
+	}
+	if !stake.Creator.Equals(creator) {
+		return Stake{}, ErrCodeCannotWithdrawStakeWrongCreator(stakeID)
+	}
+	if stake.Expired {
+		return Stake{}, ErrCodeStakeAlreadyExpired(stakeID)
+	}
+	argument, ok := k.Argument(ctx, stake.ArgumentID)
+	if !ok {
+		return Stake{}, ErrCodeUnknownArgument(stake.ArgumentID)
+	}
+
+	err = k.refundStake(ctx, stake, argument.CommunityID)
+	if err != nil {
+		return Stake{}, err
+	}
+	penalty := stake.Amount.Amount.ToDec().Mul(k.GetParams(ctx).EarlyWithdrawalPenalty).TruncateInt()
+	if penalty.IsPositive() {
+		_, err = k.bankKeeper.SubtractCoin(ctx, stake.Creator, sdk.NewCoin(stake.Amount.Denom, penalty),
+			stake.ID, TransactionStakeWithdrawalPenalty, WithCommunityID(argument.CommunityID),
+			ToModuleAccount(UserRewardPoolName),
+		)
+		if err != nil {
+			return Stake{}, err
+		}
+	}
+
+	stake.Withdrawn = true
+	stake = k.endStake(ctx, stake, RewardResultWithdrawn)
+
+	if stake.Type == StakeDownvote {
+		argument.DownvotedCount = argument.DownvotedCount - 1
+		argument.DownvotedStake = argument.DownvotedStake.Sub(stake.Amount)
+		argument.UpdatedTime = ctx.BlockHeader().Time
+		k.setArgument(ctx, argument)
+		return stake, nil
+	}
+	if stake.Type == StakeUpvote {
+		argument.UpvotedCount = argument.UpvotedCount - 1
+		argument.UpvotedStake = argument.UpvotedStake.Sub(stake.Amount)
+	}
+	argument.TotalStake = argument.TotalStake.Sub(stake.Amount)
+	argument.UpdatedTime = ctx.BlockHeader().Time
+	k.setArgument(ctx, argument)
+
+	switch {
+	case argument.StakeType == StakeBacking:
+		err = k.claimKeeper.SubtractBackingStake(ctx, argument.ClaimID, stake.Amount)
+	case argument.StakeType == StakeChallenge:
+		err = k.claimKeeper.SubtractChallengeStake(ctx, argument.ClaimID, stake.Amount)
+	}
+	if err != nil {
+		return Stake{}, err
+	}
+
+	return stake, nil
+}
+
+// EndStake ends an active stake before it expires, without paying out any interest
+func (k Keeper) EndStake(ctx sdk.Context, stakeID uint64, resultType RewardResultType) sdk.Error {
 	stake, ok := k.Stake(ctx, stakeID)
 	if !ok {
 		return ErrCodeUnknownStake(stakeID)
 	}
+	if stake.Expired {
+		return ErrCodeStakeAlreadyExpired(stakeID)
+	}
+	k.endStake(ctx, stake, resultType)
+	return nil
+}
+
+// endStake takes a stake out of the queues and marks it expired.
+// The stake keeps an empty result, the hooks are told how it ended.
+func (k Keeper) endStake(ctx sdk.Context, stake Stake, resultType RewardResultType) Stake {
+	k.RemoveFromActiveStakeQueue(ctx, stake.ID, stake.EndTime)
+	k.removeFailedStakePayout(ctx, stake.ID)
 	stake.Expired = true
 	k.setStake(ctx, stake)
-	return nil
+
+	argument, _ := k.Argument(ctx, stake.ArgumentID)
+	k.afterStakeExpired(ctx, stake, RewardResult{
+		Type:                  resultType,
+		ArgumentCreator:       argument.Creator,
+		ArgumentCreatorReward: sdk.NewInt64Coin(app.StakeDenom, 0),
+		StakeCreator:          stake.Creator,
+		StakeCreatorReward:    sdk.NewInt64Coin(app.StakeDenom, 0),
+	})
+	return stake
 }
 
 // AddAdmin adds a new admin
@@ -353,35 +757,39 @@ func (k Keeper) setArgument(ctx sdk.Context, argument Argument) {
 	k.store(ctx).Set(argumentKey(argument.ID), bz)
 }
 
-var tierLimitsEarnedCoins = []sdk.Int{
-	sdk.NewInt(app.Shanev * 10),
-	sdk.NewInt(app.Shanev * 20),
-	sdk.NewInt(app.Shanev * 30),
-	sdk.NewInt(app.Shanev * 40),
-	sdk.NewInt(app.Shanev * 50),
-}
-
-var tierLimitsStakeAmounts = []sdk.Int{
-	sdk.NewInt(app.Shanev * 1000),
-	sdk.NewInt(app.Shanev * 1500),
-	sdk.NewInt(app.Shanev * 2000),
-	sdk.NewInt(app.Shanev * 2500),
-	sdk.NewInt(app.Shanev * 3000),
-}
-
-var defaultStakeLimit = sdk.NewInt(app.Shanev * 500)
-var defaultMinimumBalance = sdk.NewInt(app.Shanev * 50)
-
 func (k Keeper) checkStakeThreshold(ctx sdk.Context, address sdk.AccAddress, amount sdk.Int) sdk.Error {
 	balance := k.bankKeeper.GetCoins(ctx, address).AmountOf(app.StakeDenom)
 	if balance.IsZero() {
 		return sdk.ErrInsufficientFunds("Insufficient coins")
 	}
 	p := k.GetParams(ctx)
-	period := p.Period
+	if balance.Sub(amount).LT(p.MinimumBalance) {
+		return ErrCodeMinBalance()
+	}
+	_, tier := k.stakeTier(ctx, address)
+	staked := k.stakedInPeriod(ctx, address)
+	if staked.Add(amount).GT(tier.MaxStake) {
+		return ErrCodeMaxAmountStakingReached()
+	}
+	return nil
+}
 
+// stakeTier returns the highest stake tier reached by the user's total earned coins
+func (k Keeper) stakeTier(ctx sdk.Context, address sdk.AccAddress) (int, StakeTier) {
+	tiers := k.GetParams(ctx).StakeTiers
+	totalEarned := k.TotalEarnedCoins(ctx, address)
+	for i := len(tiers) - 1; i > 0; i-- {
+		if totalEarned.GTE(tiers[i].EarnedThreshold) {
+			return i, tiers[i]
+		}
+	}
+	return 0, tiers[0]
+}
+
+// stakedInPeriod returns the amount a user has at stake in the current staking period
+func (k Keeper) stakedInPeriod(ctx sdk.Context, address sdk.AccAddress) sdk.Int {
 	staked := sdk.NewInt(0)
-	fromDate := ctx.BlockHeader().Time.Add(time.Duration(-1) * period)
+	fromDate := ctx.BlockHeader().Time.Add(time.Duration(-1) * k.GetParams(ctx).Period)
 	k.IterateAfterCreatedTimeUserStakes(ctx, address,
 		fromDate, func(stake Stake) bool {
 			// only account for non expired since expired would already have refunded the stake
@@ -392,46 +800,23 @@ func (k Keeper) checkStakeThreshold(ctx sdk.Context, address sdk.AccAddress, amo
 			return false
 		},
 	)
-	if balance.Sub(amount).LT(defaultMinimumBalance) {
-		return ErrCodeMinBalance()
-	}
+	return staked
+}
 
-	switch totalEarned := k.TotalEarnedCoins(ctx, address); {
-	// if total earned >= 50
-	case totalEarned.GTE(tierLimitsEarnedCoins[4]):
-		if staked.Add(amount).GT(tierLimitsStakeAmounts[4]) {
-			return ErrCodeMaxAmountStakingReached()
-		}
-		return nil
-	// if total earned >= 40
-	case totalEarned.GTE(tierLimitsEarnedCoins[3]):
-		if staked.Add(amount).GT(tierLimitsStakeAmounts[3]) {
-			return ErrCodeMaxAmountStakingReached()
-		}
-		return nil
-	// if total earned >= 30
-	case totalEarned.GTE(tierLimitsEarnedCoins[2]):
-		if staked.Add(amount).GT(tierLimitsStakeAmounts[2]) {
-			return ErrCodeMaxAmountStakingReached()
-		}
-		return nil
-	// if total earned >= 20
-	case totalEarned.GTE(tierLimitsEarnedCoins[1]):
-		if staked.Add(amount).GT(tierLimitsStakeAmounts[1]) {
-			return ErrCodeMaxAmountStakingReached()
-		}
-		return nil
-	// if total earned >= 10
-	case totalEarned.GTE(tierLimitsEarnedCoins[0]):
-		if staked.Add(amount).GT(tierLimitsStakeAmounts[0]) {
-			return ErrCodeMaxAmountStakingReached()
-		}
-		return nil
-	default:
-		if staked.Add(amount).GT(defaultStakeLimit) {
-			return ErrCodeMaxAmountStakingReached()
-		}
-		return nil
+// UserStakeLimit returns the user's current stake tier and the amount they can still stake
+func (k Keeper) UserStakeLimit(ctx sdk.Context, address sdk.AccAddress) UserStakeLimit {
+	tierIndex, tier := k.stakeTier(ctx, address)
+	staked := k.stakedInPeriod(ctx, address)
+	remaining := tier.MaxStake.Sub(staked)
+	if remaining.IsNegative() {
+		remaining = sdk.NewInt(0)
+	}
+	return UserStakeLimit{
+		Address:   address,
+		Tier:      tierIndex,
+		MaxStake:  sdk.NewCoin(app.StakeDenom, tier.MaxStake),
+		Staked:    sdk.NewCoin(app.StakeDenom, staked),
+		Remaining: sdk.NewCoin(app.StakeDenom, remaining),
 	}
 }
 
@@ -445,7 +830,7 @@ func (k Keeper) TotalEarnedCoins(ctx sdk.Context, creator sdk.AccAddress) sdk.In
 }
 
 func (k Keeper) newStake(ctx sdk.Context, amount sdk.Coin, creator sdk.AccAddress,
-	stakeType StakeType, argumentID uint64, communityID string) (Stake, sdk.Error) {
+	stakeType StakeType, argumentID uint64, communityID string, interestRate sdk.Dec) (Stake, sdk.Error) {
 	if !stakeType.Valid() {
 		return Stake{}, ErrCodeInvalidStakeType(stakeType)
 	}
@@ -453,7 +838,7 @@ func (k Keeper) newStake(ctx sdk.Context, amount sdk.Coin, creator sdk.AccAddres
 	if err != nil {
 		return Stake{}, err
 	}
-	period := k.GetParams(ctx).Period
+	period := k.CommunityParams(ctx, communityID).Period
 	stakeID, err := k.stakeID(ctx)
 	if err != nil {
 		return Stake{}, err
@@ -468,14 +853,19 @@ func (k Keeper) newStake(ctx sdk.Context, amount sdk.Coin, creator sdk.AccAddres
 	}
 
 	stake := Stake{
-		ID:          stakeID,
-		ArgumentID:  argumentID,
-		CommunityID: communityID,
-		CreatedTime: ctx.BlockHeader().Time,
-		EndTime:     ctx.BlockHeader().Time.Add(period),
-		Creator:     creator,
-		Amount:      amount,
-		Type:        stakeType,
+		ID:           stakeID,
+		ArgumentID:   argumentID,
+		CommunityID:  communityID,
+		CreatedTime:  ctx.BlockHeader().Time,
+		EndTime:      ctx.BlockHeader().Time.Add(period),
+		Creator:      creator,
+		Amount:       amount,
+		Type:         stakeType,
+		InterestRate: interestRate,
+	}
+	// the argument doesn't exist yet for the creation stake, which backs the first revision
+	if argument, ok := k.Argument(ctx, argumentID); ok {
+		stake.Revision = argument.RevisionCount
 	}
 	k.setStake(ctx, stake)
 	k.setStakeID(ctx, stakeID+1)
@@ -484,9 +874,42 @@ func (k Keeper) newStake(ctx sdk.Context, amount sdk.Coin, creator sdk.AccAddres
 	k.setUserStake(ctx, creator, stake.CreatedTime, stake.ID)
 	k.setCommunityStake(ctx, communityID, stake.ID)
 	k.setUserCommunityStake(ctx, stake.Creator, communityID, stakeID)
+	k.afterStakeCreated(ctx, stake)
 	return stake, nil
 }
 
+// refundStake returns the staked amount back to the stake creator
+func (k Keeper) refundStake(ctx sdk.Context, stake Stake, communityID string) sdk.Error {
+	var refundType TransactionType
+
+	switch stake.Type {
+	case StakeBacking:
+		refundType = TransactionBackingReturned
+	case StakeChallenge:
+		refundType = TransactionChallengeReturned
+	case StakeUpvote:
+		refundType = TransactionUpvoteReturned
+	case StakeDownvote:
+		refundType = TransactionDownvoteReturned
+	default:
+		return ErrCodeUnknownStakeType()
+	}
+
+	_, err := k.bankKeeper.AddCoin(ctx, stake.Creator, stake.Amount, stake.ArgumentID,
+		refundType, WithCommunityID(communityID),
+		FromModuleAccount(UserStakesPoolName),
+	)
+	return err
+}
+
+// forfeitStake moves the staked amount to the user reward pool instead of refunding it
+func (k Keeper) forfeitStake(ctx sdk.Context, stake Stake, communityID string) sdk.Error {
+	return k.bankKeeper.ForfeitCoin(ctx, stake.Creator, stake.Amount, stake.ArgumentID,
+		TransactionStakeForfeited, WithCommunityID(communityID),
+		FromModuleAccount(UserStakesPoolName), ToModuleAccount(UserRewardPoolName),
+	)
+}
+
 func (k Keeper) Stake(ctx sdk.Context, stakeID uint64) (Stake, bool) {
 	stake := Stake{}
 	bz := k.store(ctx).Get(stakeKey(stakeID))
@@ -530,20 +953,72 @@ func (k Keeper) getEarnedCoins(ctx sdk.Context, user sdk.AccAddress) sdk.Coins {
 }
 
 func (k Keeper) setEarnedCoins(ctx sdk.Context, user sdk.AccAddress, earnedCoins sdk.Coins) {
+	k.updateLeaderboards(ctx, user, k.getEarnedCoins(ctx, user), earnedCoins)
 	b := k.codec.MustMarshalBinaryLengthPrefixed(earnedCoins)
 	k.store(ctx).Set(userEarnedCoinsKey(user), b)
 }
 
 func (k Keeper) addEarnedCoin(ctx sdk.Context, user sdk.AccAddress, communityID string, amount sdk.Int) {
+	previousTier, _ := k.stakeTier(ctx, user)
 	earnedCoins := k.getEarnedCoins(ctx, user)
 	earnedCoins = earnedCoins.Add(sdk.NewCoins(sdk.NewCoin(communityID, amount)))
 	k.setEarnedCoins(ctx, user, earnedCoins)
+	k.recordEarnings(ctx, user, communityID, amount, sdk.ZeroInt())
+	tier, stakeTier := k.stakeTier(ctx, user)
+	if tier > previousTier {
+		k.upgradeStakeLimit(ctx, user, tier, stakeTier)
+	}
+}
+
+// upgradeStakeLimit records and announces a user reaching a higher stake tier
+func (k Keeper) upgradeStakeLimit(ctx sdk.Context, user sdk.AccAddress, tier int, stakeTier StakeTier) {
+	upgrade := StakeLimitUpgrade{
+		Address:      user,
+		NewLimit:     int(stakeTier.MaxStake.Quo(sdk.NewInt(app.Shanev)).Int64()),
+		EarnedStake:  sdk.NewCoin(app.StakeDenom, k.TotalEarnedCoins(ctx, user)),
+		Tier:         tier,
+		UpgradedTime: ctx.BlockHeader().Time,
+	}
+	k.setStakeLimitUpgrade(ctx, upgrade)
+	b := k.codec.MustMarshalJSON(upgrade)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeStakeLimitIncreased,
+			sdk.NewAttribute(AttributeKeyStakeLimitUpgrade, string(b)),
+		),
+	)
+}
+
+func (k Keeper) setStakeLimitUpgrade(ctx sdk.Context, upgrade StakeLimitUpgrade) {
+	b := k.codec.MustMarshalBinaryLengthPrefixed(upgrade)
+	k.store(ctx).Set(userStakeLimitUpgradeKey(upgrade.Address, upgrade.UpgradedTime, upgrade.Tier), b)
+}
+
+// UserStakeLimitUpgrades returns the stake limit upgrades of a user, oldest first
+func (k Keeper) UserStakeLimitUpgrades(ctx sdk.Context, user sdk.AccAddress) []StakeLimitUpgrade {
+	upgrades := make([]StakeLimitUpgrade, 0)
+	k.IterateUserStakeLimitUpgrades(ctx, user, func(upgrade StakeLimitUpgrade) bool {
+		upgrades = append(upgrades, upgrade)
+		return false
+	})
+	return upgrades
+}
+
+// StakeLimitUpgrades returns the stake limit upgrades of every user
+func (k Keeper) StakeLimitUpgrades(ctx sdk.Context) []StakeLimitUpgrade {
+	upgrades := make([]StakeLimitUpgrade, 0)
+	k.IterateStakeLimitUpgrades(ctx, func(upgrade StakeLimitUpgrade) bool {
+		upgrades = append(upgrades, upgrade)
+		return false
+	})
+	return upgrades
 }
 
 func (k Keeper) SubtractEarnedCoin(ctx sdk.Context, user sdk.AccAddress, communityID string, amount sdk.Int) {
 	earnedCoins := k.getEarnedCoins(ctx, user)
 	earnedCoins = earnedCoins.Sub(sdk.NewCoins(sdk.NewCoin(communityID, amount)))
 	k.setEarnedCoins(ctx, user, earnedCoins)
+	k.recordEarnings(ctx, user, communityID, sdk.ZeroInt(), amount)
 }
 
 func (k Keeper) stakeID(ctx sdk.Context) (uint64, sdk.Error) {
@@ -613,6 +1088,9 @@ func (k Keeper) EditArgument(ctx sdk.Context, body, summary string,
 	if !ok {
 		return Argument{}, ErrCodeUnknownArgument(argumentID)
 	}
+	if argument.Deleted {
+		return Argument{}, ErrCodeArgumentDeleted(argumentID)
+	}
 
 	isAdmin := k.isAdmin(ctx, creator)
 
@@ -625,23 +1103,59 @@ func (k Keeper) EditArgument(ctx sdk.Context, body, summary string,
 		return Argument{}, ErrCodeCannotEditArgumentAlreadyStaked(argumentID)
 	}
 
-	editedArgument := Argument{
-		ID:           argumentID,
-		Creator:      argument.Creator,
-		ClaimID:      argument.ClaimID,
-		CommunityID:  argument.CommunityID,
-		Summary:      summary,
-		Body:         body,
-		StakeType:    argument.StakeType,
-		CreatedTime:  argument.CreatedTime,
-		UpdatedTime:  argument.UpdatedTime,
-		UpvotedStake: argument.UpvotedStake,
-		TotalStake:   argument.TotalStake,
-		UpvotedCount: argument.UpvotedCount,
-		EditedTime:   ctx.BlockHeader().Time,
-		Edited:       true,
+	// arguments submitted before revisions were tracked get their original version stored first
+	if _, ok := k.ArgumentRevision(ctx, argumentID, 0); !ok {
+		k.setArgumentRevision(ctx, newArgumentRevision(argument, 0, argument.Creator, argument.CreatedTime))
 	}
 
+	editedArgument := argument
+	editedArgument.Summary = summary
+	editedArgument.Body = body
+	editedArgument.EditedTime = ctx.BlockHeader().Time
+	editedArgument.Edited = true
+	editedArgument.RevisionCount = argument.RevisionCount + 1
+	k.setArgumentRevision(ctx, newArgumentRevision(editedArgument, editedArgument.RevisionCount, creator, editedArgument.EditedTime))
+
 	k.setArgument(ctx, editedArgument)
-	return argument, nil
+	k.afterArgumentEdited(ctx, editedArgument)
+	return editedArgument, nil
+}
+
+// ArgumentRevision returns a single version of an argument
+func (k Keeper) ArgumentRevision(ctx sdk.Context, argumentID uint64, revision int) (ArgumentRevision, bool) {
+	argumentRevision := ArgumentRevision{}
+	bz := k.store(ctx).Get(argumentRevisionKey(argumentID, revision))
+	if bz == nil {
+		return argumentRevision, false
+	}
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &argumentRevision)
+	return argumentRevision, true
+}
+
+// ArgumentRevisions returns every version of an argument, oldest first
+func (k Keeper) ArgumentRevisions(ctx sdk.Context, argumentID uint64) []ArgumentRevision {
+	revisions := make([]ArgumentRevision, 0)
+	k.IterateArgumentRevisions(ctx, argumentID, func(revision ArgumentRevision) bool {
+		revisions = append(revisions, revision)
+		return false
+	})
+	return revisions
+}
+
+// AllArgumentRevisions returns the revisions of every argument
+func (k Keeper) AllArgumentRevisions(ctx sdk.Context) []ArgumentRevision {
+	revisions := make([]ArgumentRevision, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), ArgumentRevisionsKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var revision ArgumentRevision
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &revision)
+		revisions = append(revisions, revision)
+	}
+	return revisions
+}
+
+func (k Keeper) setArgumentRevision(ctx sdk.Context, revision ArgumentRevision) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(revision)
+	k.store(ctx).Set(argumentRevisionKey(revision.ArgumentID, revision.Revision), bz)
 }
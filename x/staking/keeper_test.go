@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	app "github.com/TruStory/truchain/types"
+	"github.com/TruStory/truchain/x/account"
 	"github.com/TruStory/truchain/x/bank"
 	"github.com/TruStory/truchain/x/claim"
 )
@@ -58,19 +59,20 @@ func TestKeeper_SubmitArgument(t *testing.T) {
 	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
 	assert.NoError(t, err)
 	expectedArgument := Argument{
-		ID:           1,
-		Creator:      addr,
-		ClaimID:      1,
-		CommunityID:  "testunit",
-		Summary:      "summary",
-		Body:         "body",
-		StakeType:    StakeBacking,
-		CreatedTime:  ctx.BlockHeader().Time,
-		EditedTime:   ctx.BlockHeader().Time,
-		UpdatedTime:  ctx.BlockHeader().Time,
-		UpvotedCount: 0,
-		UpvotedStake: sdk.NewInt64Coin(app.StakeDenom, 0),
-		TotalStake:   sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50),
+		ID:             1,
+		Creator:        addr,
+		ClaimID:        1,
+		CommunityID:    "testunit",
+		Summary:        "summary",
+		Body:           "body",
+		StakeType:      StakeBacking,
+		CreatedTime:    ctx.BlockHeader().Time,
+		EditedTime:     ctx.BlockHeader().Time,
+		UpdatedTime:    ctx.BlockHeader().Time,
+		UpvotedCount:   0,
+		UpvotedStake:   sdk.NewInt64Coin(app.StakeDenom, 0),
+		TotalStake:     sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50),
+		DownvotedStake: sdk.NewInt64Coin(app.StakeDenom, 0),
 	}
 	assert.Equal(t, expectedArgument, argument)
 	argument, ok := k.Argument(ctx, expectedArgument.ID)
@@ -78,41 +80,44 @@ func TestKeeper_SubmitArgument(t *testing.T) {
 	assert.Equal(t, expectedArgument, argument)
 
 	expectedStake := Stake{
-		ID:          1,
-		ArgumentID:  1,
-		CommunityID: "testunit",
-		Type:        StakeBacking,
-		Amount:      sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50),
-		Creator:     addr,
-		CreatedTime: ctx.BlockHeader().Time,
-		EndTime:     ctx.BlockHeader().Time.Add(time.Hour * 24 * 7),
+		ID:           1,
+		ArgumentID:   1,
+		CommunityID:  "testunit",
+		Type:         StakeBacking,
+		Amount:       sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50),
+		Creator:      addr,
+		CreatedTime:  ctx.BlockHeader().Time,
+		EndTime:      ctx.BlockHeader().Time.Add(time.Hour * 24 * 7),
+		InterestRate: sdk.NewDecWithPrec(105, 2),
 	}
 	s, _ := k.Stake(ctx, 1)
 	assert.Equal(t, expectedStake, s)
 	argument2, err := k.SubmitArgument(ctx, "body2", "summary2", addr2, 1, StakeChallenge)
 	expectedArgument2 := Argument{
+		ID:             2,
+		Creator:        addr2,
+		ClaimID:        1,
+		CommunityID:    "testunit",
+		Summary:        "summary2",
+		Body:           "body2",
+		StakeType:      StakeChallenge,
+		CreatedTime:    ctx.BlockHeader().Time,
+		EditedTime:     ctx.BlockHeader().Time,
+		UpdatedTime:    ctx.BlockHeader().Time,
+		UpvotedStake:   sdk.NewInt64Coin(app.StakeDenom, 0),
+		TotalStake:     sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50),
+		DownvotedStake: sdk.NewInt64Coin(app.StakeDenom, 0),
+	}
+	expectedStake2 := Stake{
 		ID:           2,
-		Creator:      addr2,
-		ClaimID:      1,
+		ArgumentID:   2,
 		CommunityID:  "testunit",
-		Summary:      "summary2",
-		Body:         "body2",
-		StakeType:    StakeChallenge,
+		Type:         StakeChallenge,
+		Amount:       sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50),
+		Creator:      addr2,
 		CreatedTime:  ctx.BlockHeader().Time,
-		EditedTime:   ctx.BlockHeader().Time,
-		UpdatedTime:  ctx.BlockHeader().Time,
-		UpvotedStake: sdk.NewInt64Coin(app.StakeDenom, 0),
-		TotalStake:   sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50),
-	}
-	expectedStake2 := Stake{
-		ID:          2,
-		ArgumentID:  2,
-		CommunityID: "testunit",
-		Type:        StakeChallenge,
-		Amount:      sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50),
-		Creator:     addr2,
-		CreatedTime: ctx.BlockHeader().Time,
-		EndTime:     ctx.BlockHeader().Time.Add(time.Hour * 24 * 7),
+		EndTime:      ctx.BlockHeader().Time.Add(time.Hour * 24 * 7),
+		InterestRate: sdk.NewDecWithPrec(105, 2),
 	}
 	assert.NoError(t, err)
 	assert.Equal(t, expectedArgument2, argument2)
@@ -253,14 +258,15 @@ func TestKeeper_SubmitUpvote(t *testing.T) {
 	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
 	assert.NoError(t, err)
 	expectedStake := Stake{
-		ID:          1,
-		ArgumentID:  1,
-		CommunityID: "testunit",
-		Type:        StakeBacking,
-		Amount:      sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50),
-		Creator:     addr,
-		CreatedTime: ctx.BlockHeader().Time,
-		EndTime:     ctx.BlockHeader().Time.Add(time.Hour * 24 * 7),
+		ID:           1,
+		ArgumentID:   1,
+		CommunityID:  "testunit",
+		Type:         StakeBacking,
+		Amount:       sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50),
+		Creator:      addr,
+		CreatedTime:  ctx.BlockHeader().Time,
+		EndTime:      ctx.BlockHeader().Time.Add(time.Hour * 24 * 7),
+		InterestRate: sdk.NewDecWithPrec(105, 2),
 	}
 	s, ok := k.Stake(ctx, 1)
 	assert.True(t, ok)
@@ -268,14 +274,15 @@ func TestKeeper_SubmitUpvote(t *testing.T) {
 	_, err = k.SubmitUpvote(ctx, argument.ID, addr2)
 	assert.NoError(t, err)
 	expectedStake2 := Stake{
-		ID:          2,
-		ArgumentID:  1,
-		CommunityID: "testunit",
-		Type:        StakeUpvote,
-		Amount:      sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10),
-		Creator:     addr2,
-		CreatedTime: ctx.BlockHeader().Time,
-		EndTime:     ctx.BlockHeader().Time.Add(time.Hour * 24 * 7),
+		ID:           2,
+		ArgumentID:   1,
+		CommunityID:  "testunit",
+		Type:         StakeUpvote,
+		Amount:       sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10),
+		Creator:      addr2,
+		CreatedTime:  ctx.BlockHeader().Time,
+		EndTime:      ctx.BlockHeader().Time.Add(time.Hour * 24 * 7),
+		InterestRate: sdk.NewDecWithPrec(105, 2),
 	}
 	// fail if argument doesn't exist
 	_, err = k.SubmitUpvote(ctx, 9999, addr)
@@ -336,13 +343,78 @@ func TestKeeper_SubmitUpvote(t *testing.T) {
 	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*70), argument.TotalStake)
 }
 
+func TestKeeper_SubmitDownvote(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr3 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+
+	stake, err := k.SubmitDownvote(ctx, argument.ID, addr2)
+	assert.NoError(t, err)
+	expectedStake := Stake{
+		ID:           2,
+		ArgumentID:   1,
+		CommunityID:  "testunit",
+		Type:         StakeDownvote,
+		Amount:       sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10),
+		Creator:      addr2,
+		CreatedTime:  ctx.BlockHeader().Time,
+		EndTime:      ctx.BlockHeader().Time.Add(time.Hour * 24 * 7),
+		InterestRate: sdk.ZeroDec(),
+	}
+	assert.Equal(t, expectedStake, stake)
+
+	// fail if argument doesn't exist
+	_, err = k.SubmitDownvote(ctx, 9999, addr2)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeUnknownArgument, err.Code())
+	// can't downvote twice or downvote your own argument
+	_, err = k.SubmitDownvote(ctx, argument.ID, addr2)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeDuplicateStake, err.Code())
+	_, err = k.SubmitDownvote(ctx, argument.ID, addr)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeDuplicateStake, err.Code())
+	// can't upvote after downvoting
+	_, err = k.SubmitUpvote(ctx, argument.ID, addr2)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeDuplicateStake, err.Code())
+
+	user2Txs := k.bankKeeper.TransactionsByAddress(ctx, addr2)
+	assert.Len(t, user2Txs, 1)
+	assert.Equal(t, bank.TransactionDownvote, user2Txs[0].Type)
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10), user2Txs[0].Amount)
+
+	_, err = k.SubmitDownvote(ctx, argument.ID, addr3)
+	assert.NoError(t, err)
+
+	// downvotes are tracked apart from the argument's total stake
+	argument, ok := k.Argument(ctx, argument.ID)
+	assert.True(t, ok)
+	assert.Equal(t, 0, argument.UpvotedCount)
+	assert.Equal(t, 2, argument.DownvotedCount)
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*20), argument.DownvotedStake)
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50), argument.TotalStake)
+
+	_, err = k.WithdrawStake(ctx, stake.ID, addr2)
+	assert.NoError(t, err)
+	argument, ok = k.Argument(ctx, argument.ID)
+	assert.True(t, ok)
+	assert.Equal(t, 1, argument.DownvotedCount)
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10), argument.DownvotedStake)
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50), argument.TotalStake)
+}
+
 func Test_interest(t *testing.T) {
 	ctx, k, _ := mockDB()
 	amount := sdk.NewInt64Coin(app.StakeDenom, 50000000000)
 	now := time.Now()
 	p := k.GetParams(ctx)
 	after7days := now.Add(p.Period)
-	interest := k.interest(ctx, amount, after7days.Sub(now))
+	interest := k.interest(ctx, "crypto", amount, after7days.Sub(now))
 	assert.Equal(t, sdk.NewInt(1006849315), interest.RoundInt())
 }
 
@@ -352,10 +424,10 @@ func Test_splitReward(t *testing.T) {
 	now := time.Now()
 	p := k.GetParams(ctx)
 	after7days := now.Add(p.Period)
-	interest := k.interest(ctx, amount, after7days.Sub(now))
+	interest := k.interest(ctx, "crypto", amount, after7days.Sub(now))
 	t.Log("interest: " + interest.String())
 
-	creatorReward, stakerReward := k.splitReward(ctx, interest)
+	creatorReward, stakerReward := k.splitReward(ctx, "crypto", interest)
 	expectedCreatorReward := sdk.NewDecFromInt(sdk.NewInt(10068493150685)).
 		Mul(sdk.NewDecWithPrec(50, 2))
 	t.Log("expected creator reward: " + expectedCreatorReward.String())
@@ -393,7 +465,7 @@ func TestKeeper_StakeLimitTiers(t *testing.T) {
 		{"2500 limit", 2700, 51, 49},
 		{"3000 limit", 5000, 61, 51},
 	}
-	assert.Len(t, tierTests, len(tierLimitsEarnedCoins))
+	assert.Len(t, tierTests, len(DefaultStakeTiers())-1)
 	for _, tt := range tierTests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctx, k, mdb := mockDB()
@@ -542,6 +614,462 @@ func TestKeeper_ClaimTotalsAdded(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestKeeper_SubmitReply(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	mockedClaimKeeper.enableTrackStake = true
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr3 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	claims := make(map[uint64]claim.Claim)
+	for id := uint64(1); id <= 2; id++ {
+		claims[id] = claim.Claim{
+			ID:              id,
+			CommunityID:     "crypto",
+			Body:            "body",
+			Creator:         addr,
+			TotalBacked:     sdk.NewInt64Coin(app.StakeDenom, 0),
+			TotalChallenged: sdk.NewInt64Coin(app.StakeDenom, 0),
+		}
+	}
+	mockedClaimKeeper.SetClaims(claims)
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), argument.ParentArgumentID)
+
+	// replies take the opposite side of their parent
+	_, err = k.SubmitReply(ctx, "reply", "summary", addr2, 1, argument.ID, StakeBacking)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeInvalidStakeType, err.Code())
+	// and must belong to the same claim
+	_, err = k.SubmitReply(ctx, "reply", "summary", addr2, 2, argument.ID, StakeChallenge)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeInvalidParentArgument, err.Code())
+	_, err = k.SubmitReply(ctx, "reply", "summary", addr2, 1, 9999, StakeChallenge)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeUnknownArgument, err.Code())
+
+	reply, err := k.SubmitReply(ctx, "reply", "summary", addr2, 1, argument.ID, StakeChallenge)
+	assert.NoError(t, err)
+	assert.Equal(t, argument.ID, reply.ParentArgumentID)
+	_, err = k.SubmitUpvote(ctx, reply.ID, addr3)
+	assert.NoError(t, err)
+
+	// reply stakes count towards the other side of the claim
+	claim1, ok := mockedClaimKeeper.Claim(ctx, 1)
+	assert.True(t, ok)
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50).String(), claim1.TotalBacked.String())
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*60).String(), claim1.TotalChallenged.String())
+
+	replies := k.ArgumentReplies(ctx, argument.ID)
+	assert.Len(t, replies, 1)
+	assert.Equal(t, reply.ID, replies[0].ID)
+	assert.Len(t, k.ClaimArguments(ctx, 1), 2)
+
+	// nesting is limited by the max argument depth
+	params := k.GetParams(ctx)
+	params.MaxArgumentDepth = 2
+	k.SetParams(ctx, params)
+	reply2, err := k.SubmitReply(ctx, "reply2", "summary", addr, 1, reply.ID, StakeBacking)
+	assert.NoError(t, err)
+	_, err = k.SubmitReply(ctx, "reply3", "summary", addr2, 1, reply2.ID, StakeChallenge)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeMaxArgumentDepthReached, err.Code())
+
+	// deleting a reply removes it from the thread
+	_, err = k.DeleteArgument(ctx, reply2.ID, addr)
+	assert.NoError(t, err)
+	assert.Len(t, k.ArgumentReplies(ctx, reply.ID), 0)
+}
+
+func TestKeeper_EditArgumentRevisions(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(mustParseTime("2019-01-01"))
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr3 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	admin := k.GetParams(ctx).StakingAdmins[0]
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	upvote, err := k.SubmitUpvote(ctx, argument.ID, addr2)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, upvote.Revision)
+
+	// only admins can edit once others staked
+	_, err = k.EditArgument(ctx, "edited body", "edited summary", addr, argument.ID)
+	assert.Error(t, err)
+	editTime := mustParseTime("2019-01-02")
+	edited, err := k.EditArgument(ctx.WithBlockTime(editTime), "edited body", "edited summary", admin, argument.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, edited.RevisionCount)
+	assert.Equal(t, "edited body", edited.Body)
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*60), edited.TotalStake)
+
+	upvote2, err := k.SubmitUpvote(ctx, argument.ID, addr3)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, upvote2.Revision)
+
+	revisions := k.ArgumentRevisions(ctx, argument.ID)
+	assert.Len(t, revisions, 2)
+	assert.Equal(t, ArgumentRevision{
+		ArgumentID: argument.ID,
+		Revision:   0,
+		Editor:     addr,
+		EditedTime: argument.CreatedTime,
+		Summary:    "summary",
+		Body:       "body",
+		Hash:       argumentHash("summary", "body"),
+	}, revisions[0])
+	assert.Equal(t, ArgumentRevision{
+		ArgumentID: argument.ID,
+		Revision:   1,
+		Editor:     admin,
+		EditedTime: editTime,
+		Summary:    "edited summary",
+		Body:       "edited body",
+		Hash:       argumentHash("edited summary", "edited body"),
+	}, revisions[1])
+	assert.NotEqual(t, revisions[0].Hash, revisions[1].Hash)
+}
+
+func TestKeeper_DeleteArgument(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	mockedClaimKeeper.enableTrackStake = true
+	claims := make(map[uint64]claim.Claim)
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*250)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*250)})
+	admin := k.GetParams(ctx).StakingAdmins[0]
+	claims[1] = claim.Claim{
+		ID:              1,
+		CommunityID:     "crypto",
+		Body:            "body",
+		Creator:         addr,
+		TotalBacked:     sdk.NewInt64Coin(app.StakeDenom, 0),
+		TotalChallenged: sdk.NewInt64Coin(app.StakeDenom, 0),
+	}
+	mockedClaimKeeper.SetClaims(claims)
+
+	arg1, err := k.SubmitArgument(ctx, "arg1", "summary1", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	arg2, err := k.SubmitArgument(ctx, "arg2", "summary2", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	_, err = k.SubmitUpvote(ctx, arg2.ID, addr2)
+	assert.NoError(t, err)
+
+	_, err = k.DeleteArgument(ctx, arg1.ID, addr2)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeCannotDeleteArgumentWrongCreator, err.Code())
+
+	_, err = k.DeleteArgument(ctx, arg2.ID, addr)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeCannotDeleteArgumentAlreadyStaked, err.Code())
+
+	// creator deletes an argument nobody else staked on and gets the stake back
+	deleted, err := k.DeleteArgument(ctx, arg1.ID, addr)
+	assert.NoError(t, err)
+	assert.True(t, deleted.Deleted)
+	assert.Equal(t, sdk.NewInt(app.Shanev*200), k.bankKeeper.GetCoins(ctx, addr).AmountOf(app.StakeDenom))
+	assert.Len(t, k.ClaimArguments(ctx, 1), 1)
+	assert.Len(t, k.UserArguments(ctx, addr), 1)
+	stake, ok := k.Stake(ctx, 1)
+	assert.True(t, ok)
+	assert.True(t, stake.Expired)
+
+	_, err = k.DeleteArgument(ctx, arg1.ID, addr)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeArgumentDeleted, err.Code())
+
+	// admin deletes an upvoted argument, upvoter is refunded and creator forfeits the stake
+	_, err = k.DeleteArgument(ctx, arg2.ID, admin)
+	assert.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(app.Shanev*200), k.bankKeeper.GetCoins(ctx, addr).AmountOf(app.StakeDenom))
+	assert.Equal(t, sdk.NewInt(app.Shanev*250), k.bankKeeper.GetCoins(ctx, addr2).AmountOf(app.StakeDenom))
+	assert.Len(t, k.ClaimArguments(ctx, 1), 0)
+	assert.Len(t, k.UserArguments(ctx, addr), 0)
+	forfeits := k.bankKeeper.TransactionsByAddress(ctx, addr, bank.FilterByTransactionType(TransactionStakeForfeited))
+	assert.Len(t, forfeits, 1)
+	assert.Equal(t, arg2.ID, forfeits[0].ReferenceID)
+	assert.Equal(t, k.GetParams(ctx).ArgumentCreationStake, forfeits[0].Amount)
+	assert.Equal(t, UserRewardPoolName, forfeits[0].ToModuleAccount)
+
+	claim1, ok := mockedClaimKeeper.Claim(ctx, 1)
+	assert.True(t, ok)
+	assert.True(t, claim1.TotalBacked.IsZero())
+
+	activeStakes := 0
+	k.IterateActiveStakeQueue(ctx, ctx.BlockHeader().Time.Add(time.Hour*24*30), func(stake Stake) bool {
+		activeStakes++
+		return false
+	})
+	assert.Equal(t, 0, activeStakes)
+
+	_, err = k.SubmitUpvote(ctx, arg2.ID, addr2)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeArgumentDeleted, err.Code())
+
+	// tombstone remains queryable by id
+	argument, ok := k.Argument(ctx, arg2.ID)
+	assert.True(t, ok)
+	assert.True(t, argument.Deleted)
+}
+
+func TestKeeper_WithdrawStake(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	mockedClaimKeeper.enableTrackStake = true
+	claims := make(map[uint64]claim.Claim)
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*250)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*250)})
+	claims[1] = claim.Claim{
+		ID:              1,
+		CommunityID:     "crypto",
+		Body:            "body",
+		Creator:         addr,
+		TotalBacked:     sdk.NewInt64Coin(app.StakeDenom, 0),
+		TotalChallenged: sdk.NewInt64Coin(app.StakeDenom, 0),
+	}
+	mockedClaimKeeper.SetClaims(claims)
+
+	argument, err := k.SubmitArgument(ctx, "arg1", "summary1", addr, 1, StakeChallenge)
+	assert.NoError(t, err)
+	upvote, err := k.SubmitUpvote(ctx, argument.ID, addr2)
+	assert.NoError(t, err)
+
+	_, err = k.WithdrawStake(ctx, upvote.ID, addr)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeCannotWithdrawStakeWrongCreator, err.Code())
+
+	rewardPool := k.supplyKeeper.GetModuleAccount(ctx, UserRewardPoolName).GetCoins().AmountOf(app.StakeDenom)
+	stake, err := k.WithdrawStake(ctx, upvote.ID, addr2)
+	assert.NoError(t, err)
+	assert.True(t, stake.Expired)
+	assert.True(t, stake.Withdrawn)
+
+	// 10% of the 10 TRU upvote is forfeited
+	assert.Equal(t, sdk.NewInt(app.Shanev*249), k.bankKeeper.GetCoins(ctx, addr2).AmountOf(app.StakeDenom))
+	assert.Equal(t, rewardPool.Add(sdk.NewInt(app.Shanev*1)),
+		k.supplyKeeper.GetModuleAccount(ctx, UserRewardPoolName).GetCoins().AmountOf(app.StakeDenom))
+
+	argument, ok := k.Argument(ctx, argument.ID)
+	assert.True(t, ok)
+	assert.Equal(t, 0, argument.UpvotedCount)
+	assert.True(t, argument.UpvotedStake.IsZero())
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50), argument.TotalStake)
+
+	claim1, ok := mockedClaimKeeper.Claim(ctx, 1)
+	assert.True(t, ok)
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50), claim1.TotalChallenged)
+
+	_, err = k.WithdrawStake(ctx, upvote.ID, addr2)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeStakeAlreadyExpired, err.Code())
+
+	activeStakes := make([]Stake, 0)
+	k.IterateActiveStakeQueue(ctx, ctx.BlockHeader().Time.Add(time.Hour*24*30), func(stake Stake) bool {
+		activeStakes = append(activeStakes, stake)
+		return false
+	})
+	assert.Len(t, activeStakes, 1)
+	assert.Equal(t, uint64(1), activeStakes[0].ID)
+}
+
+func TestKeeper_UnjailUpvotes(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	accountKeeper := mdb.accountKeeper.(*mockedAccountKeeper)
+	p := k.GetParams(ctx)
+	p.UnjailUpvotes = 2
+	k.SetParams(ctx, p)
+
+	creator := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	upvoters := make([]sdk.AccAddress, 0)
+	for i := 0; i < 4; i++ {
+		upvoters = append(upvoters,
+			createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)}))
+	}
+	argument, err := k.SubmitArgument(ctx, "body", "summary", creator, 1, StakeBacking)
+	assert.NoError(t, err)
+
+	// first jail term gets a single upvote
+	accountKeeper.jailUntil(creator, mustParseTime("2019-07-01"))
+	_, err = k.SubmitUpvote(ctx, argument.ID, upvoters[0])
+	assert.NoError(t, err)
+	jailed, _ := accountKeeper.IsJailed(ctx, creator)
+	assert.True(t, jailed)
+
+	// jailed accounts can't upvote
+	accountKeeper.jail(upvoters[1])
+	_, err = k.SubmitUpvote(ctx, argument.ID, upvoters[1])
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeAccountJailed, err.Code())
+
+	// the upvote from the previous term is not counted
+	accountKeeper.jailUntil(creator, mustParseTime("2019-08-01"))
+	_, err = k.SubmitUpvote(ctx, argument.ID, upvoters[2])
+	assert.NoError(t, err)
+	jailed, _ = accountKeeper.IsJailed(ctx, creator)
+	assert.True(t, jailed)
+	progress := k.AllUnjailUpvotes(ctx)
+	assert.Equal(t, []UnjailUpvotes{{Address: creator, JailEndTime: mustParseTime("2019-08-01"), Upvotes: 1}}, progress)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = k.SubmitUpvote(ctx, argument.ID, upvoters[3])
+	assert.NoError(t, err)
+	jailed, _ = accountKeeper.IsJailed(ctx, creator)
+	assert.False(t, jailed)
+	assert.Len(t, k.AllUnjailUpvotes(ctx), 0)
+
+	unjailEvents := make([]sdk.Event, 0)
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == account.EventTypeUnjailedAccount {
+			unjailEvents = append(unjailEvents, event)
+		}
+	}
+	assert.Len(t, unjailEvents, 1)
+	assert.Equal(t, creator.String(), string(unjailEvents[0].Attributes[0].Value))
+	assert.Equal(t, account.AttributeKeyReason, string(unjailEvents[0].Attributes[1].Key))
+	assert.Equal(t, account.AttributeValueReasonUpvotes, string(unjailEvents[0].Attributes[1].Value))
+}
+
+func TestKeeper_StakeLimitUpgrade(t *testing.T) {
+	ctx, k, _ := mockDB()
+	_, _, addr := keyPubAddr()
+
+	k.addEarnedCoin(ctx, addr, "crypto", sdk.NewInt(app.Shanev*5))
+	assert.Len(t, ctx.EventManager().Events(), 0)
+	assert.Len(t, k.UserStakeLimitUpgrades(ctx, addr), 0)
+
+	k.addEarnedCoin(ctx, addr, "crypto", sdk.NewInt(app.Shanev*6))
+	events := ctx.EventManager().Events()
+	assert.Len(t, events, 1)
+	assert.Equal(t, EventTypeStakeLimitIncreased, events[0].Type)
+	assert.Equal(t, AttributeKeyStakeLimitUpgrade, string(events[0].Attributes[0].Key))
+
+	// jumping over a tier records only the highest one reached
+	k.addEarnedCoin(ctx, addr, "meme", sdk.NewInt(app.Shanev*25))
+	upgrades := k.UserStakeLimitUpgrades(ctx, addr)
+	assert.Len(t, upgrades, 2)
+	assert.Equal(t, 1, upgrades[0].Tier)
+	assert.Equal(t, 1000, upgrades[0].NewLimit)
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*11), upgrades[0].EarnedStake)
+	assert.Equal(t, 3, upgrades[1].Tier)
+	assert.Equal(t, 2000, upgrades[1].NewLimit)
+	assert.Len(t, k.StakeLimitUpgrades(ctx), 2)
+}
+
+func TestUpdateParams_StakeTiers(t *testing.T) {
+	ctx, keeper, _ := mockDB()
+
+	updater := keeper.GetParams(ctx).StakingAdmins[0]
+	tiers := []StakeTier{
+		{EarnedThreshold: sdk.NewInt(0), MaxStake: sdk.NewInt(app.Shanev * 100)},
+		{EarnedThreshold: sdk.NewInt(app.Shanev * 5), MaxStake: sdk.NewInt(app.Shanev * 200)},
+	}
+	err := keeper.UpdateParams(ctx, updater, Params{StakeTiers: tiers}, []string{"stake_tiers"})
+	assert.Nil(t, err)
+	assert.Equal(t, tiers, keeper.GetParams(ctx).StakeTiers)
+
+	err = keeper.UpdateParams(ctx, updater, Params{StakeTiers: []StakeTier{}}, []string{"stake_tiers"})
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeInvalidParams, err.Code())
+	assert.Equal(t, tiers, keeper.GetParams(ctx).StakeTiers)
+}
+
+func TestUpdateParams_EarlyWithdrawalPenalty(t *testing.T) {
+	ctx, keeper, _ := mockDB()
+
+	updater := keeper.GetParams(ctx).StakingAdmins[0]
+	err := keeper.UpdateParams(ctx, updater, Params{EarlyWithdrawalPenalty: sdk.OneDec()}, []string{"early_withdrawal_penalty"})
+	assert.Nil(t, err)
+	assert.Equal(t, sdk.OneDec(), keeper.GetParams(ctx).EarlyWithdrawalPenalty)
+
+	for _, penalty := range []sdk.Dec{sdk.NewDecWithPrec(11, 1), sdk.NewDecWithPrec(-1, 2)} {
+		err = keeper.UpdateParams(ctx, updater, Params{EarlyWithdrawalPenalty: penalty}, []string{"early_withdrawal_penalty"})
+		assert.NotNil(t, err)
+		assert.Equal(t, ErrorCodeInvalidParams, err.Code())
+		assert.Equal(t, sdk.OneDec(), keeper.GetParams(ctx).EarlyWithdrawalPenalty)
+	}
+}
+
+func TestUpdateParams_UnsetValues(t *testing.T) {
+	ctx, keeper, _ := mockDB()
+	updater := keeper.GetParams(ctx).StakingAdmins[0]
+
+	// fields updated without a value are rejected instead of stored unset
+	err := keeper.UpdateParams(ctx, updater, Params{}, []string{"early_withdrawal_penalty"})
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeInvalidParams, err.Code())
+	err = keeper.UpdateParams(ctx, updater, Params{}, []string{"minimum_balance"})
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeInvalidParams, err.Code())
+	assert.Equal(t, DefaultParams().MinimumBalance, keeper.GetParams(ctx).MinimumBalance)
+}
+
+func TestKeeper_CommunityParams(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	admin := k.GetParams(ctx).StakingAdmins[0]
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	claims := make(map[uint64]claim.Claim)
+	claims[1] = claim.Claim{ID: 1, CommunityID: "crypto", Body: "body", Creator: addr}
+	claims[2] = claim.Claim{ID: 2, CommunityID: "meme", Body: "body", Creator: addr}
+	mockedClaimKeeper.SetClaims(claims)
+
+	global := k.GetParams(ctx)
+	assert.Equal(t, global, k.CommunityParams(ctx, "crypto"))
+
+	updates := CommunityStakingParams{
+		Period:                time.Hour * 24,
+		ArgumentCreationStake: sdk.NewInt64Coin(app.StakeDenom, app.Shanev*20),
+		InterestRate:          sdk.NewDecWithPrec(50, 2),
+	}
+	_, _, notAdmin := keyPubAddr()
+	err := k.UpdateCommunityStakingParams(ctx, notAdmin, "crypto", updates, []string{"period"})
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+
+	err = k.UpdateCommunityStakingParams(ctx, admin, "crypto", updates, []string{"staking_admins"})
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeInvalidParams, err.Code())
+
+	err = k.UpdateCommunityStakingParams(ctx, admin, "crypto", updates,
+		[]string{"period", "argument_creation_stake", "interest_rate"})
+	assert.Nil(t, err)
+
+	p := k.CommunityParams(ctx, "crypto")
+	assert.Equal(t, time.Hour*24, p.Period)
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*20), p.ArgumentCreationStake)
+	assert.Equal(t, sdk.NewDecWithPrec(50, 2), p.InterestRate)
+	assert.Equal(t, global.UpvoteStake, p.UpvoteStake)
+	assert.Equal(t, global.CreatorShare, p.CreatorShare)
+	assert.Equal(t, global, k.CommunityParams(ctx, "meme"))
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*20), argument.TotalStake)
+	stakes := k.ArgumentStakes(ctx, argument.ID)
+	assert.Len(t, stakes, 1)
+	assert.Equal(t, ctx.BlockHeader().Time.Add(time.Hour*24), stakes[0].EndTime)
+
+	argument, err = k.SubmitArgument(ctx, "body", "summary", addr, 2, StakeBacking)
+	assert.NoError(t, err)
+	assert.Equal(t, global.ArgumentCreationStake, argument.TotalStake)
+
+	amount := sdk.NewInt64Coin(app.StakeDenom, app.Shanev*100)
+	assert.Equal(t, Interest(sdk.NewDecWithPrec(50, 2), amount, time.Hour), k.interest(ctx, "crypto", amount, time.Hour))
+	assert.Equal(t, Interest(global.InterestRate, amount, time.Hour), k.interest(ctx, "meme", amount, time.Hour))
+
+	assert.Len(t, k.AllCommunityStakingParams(ctx), 1)
+	err = k.RemoveCommunityStakingParams(ctx, admin, "crypto")
+	assert.Nil(t, err)
+	assert.Equal(t, global, k.CommunityParams(ctx, "crypto"))
+	err = k.RemoveCommunityStakingParams(ctx, admin, "crypto")
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeUnknownCommunityStakingParams, err.Code())
+}
+
 func TestAddAdmin_Success(t *testing.T) {
 	ctx, keeper, _ := mockDB()
 
@@ -589,3 +1117,124 @@ func TestRemoveAdmin_RemoverNotAuthorised(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, ErrAddressNotAuthorised().Code(), err.Code())
 }
+
+func TestImbalanceInterestRate(t *testing.T) {
+	rate := sdk.NewDecWithPrec(10, 2)
+	bonus := sdk.NewDecWithPrec(50, 2)
+	discount := sdk.NewDecWithPrec(20, 2)
+
+	// balanced or empty claims pay the base rate
+	assert.Equal(t, rate, ImbalanceInterestRate(rate, sdk.NewInt(0), sdk.NewInt(0), bonus, discount))
+	assert.Equal(t, rate, ImbalanceInterestRate(rate, sdk.NewInt(50), sdk.NewInt(50), bonus, discount))
+	// 25 vs 75 is an imbalance of 0.5
+	assert.Equal(t, sdk.NewDecWithPrec(125, 3), ImbalanceInterestRate(rate, sdk.NewInt(25), sdk.NewInt(75), bonus, discount))
+	assert.Equal(t, sdk.NewDecWithPrec(9, 2), ImbalanceInterestRate(rate, sdk.NewInt(75), sdk.NewInt(25), bonus, discount))
+	// a lone side gets the full bonus or discount
+	assert.Equal(t, sdk.NewDecWithPrec(15, 2), ImbalanceInterestRate(rate, sdk.NewInt(0), sdk.NewInt(10), bonus, discount))
+	assert.Equal(t, sdk.NewDecWithPrec(8, 2), ImbalanceInterestRate(rate, sdk.NewInt(10), sdk.NewInt(0), bonus, discount))
+}
+
+func TestKeeper_ImbalanceInterestRate(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	admin := k.GetParams(ctx).StakingAdmins[0]
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	mockedClaimKeeper.SetClaims(map[uint64]claim.Claim{
+		1: {
+			ID:              1,
+			CommunityID:     "testunit",
+			TotalBacked:     sdk.NewInt64Coin(app.StakeDenom, app.Shanev*150),
+			TotalChallenged: sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50),
+		},
+	})
+
+	err := k.UpdateParams(ctx, admin, Params{MajorityInterestDiscount: sdk.OneDec()}, []string{"majority_interest_discount"})
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeInvalidParams, err.Code())
+	err = k.UpdateParams(ctx, admin,
+		Params{MinorityInterestBonus: sdk.NewDecWithPrec(50, 2), MajorityInterestDiscount: sdk.NewDecWithPrec(20, 2)},
+		[]string{"minority_interest_bonus", "majority_interest_discount"})
+	assert.Nil(t, err)
+
+	base := k.GetParams(ctx).InterestRate
+	backing, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	challenge, err := k.SubmitArgument(ctx, "body", "summary", addr2, 1, StakeChallenge)
+	assert.NoError(t, err)
+	upvote, err := k.SubmitUpvote(ctx, challenge.ID, addr)
+	assert.NoError(t, err)
+
+	// backed 150 vs challenged 50 is an imbalance of 0.5
+	backingStakes := k.ArgumentStakes(ctx, backing.ID)
+	assert.Equal(t, base.Mul(sdk.NewDecWithPrec(90, 2)), backingStakes[0].InterestRate)
+	challengeStakes := k.ArgumentStakes(ctx, challenge.ID)
+	assert.Equal(t, base.Mul(sdk.NewDecWithPrec(125, 2)), challengeStakes[0].InterestRate)
+	assert.Equal(t, base.Mul(sdk.NewDecWithPrec(125, 2)), upvote.InterestRate)
+
+	// the payout uses the rate stored on the stake, not the current params
+	err = k.UpdateParams(ctx, admin, Params{MinorityInterestBonus: sdk.ZeroDec()}, []string{"minority_interest_bonus"})
+	assert.Nil(t, err)
+	projection, err := k.ProjectStake(ctx, challengeStakes[0])
+	assert.NoError(t, err)
+	expected := Interest(challengeStakes[0].InterestRate, challengeStakes[0].Amount, k.GetParams(ctx).Period)
+	assert.Equal(t, expected.RoundInt(), projection.ArgumentCreatorReward.Amount)
+}
+
+func TestKeeper_ZeroInterestRate(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	admin := k.GetParams(ctx).StakingAdmins[0]
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+
+	err := k.UpdateParams(ctx, admin, Params{InterestRate: sdk.ZeroDec()}, []string{"interest_rate"})
+	assert.Nil(t, err)
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	stakes := k.ArgumentStakes(ctx, argument.ID)
+	assert.True(t, stakes[0].InterestRate.IsZero())
+
+	// a stake created at a zero rate keeps earning nothing after the rate changes
+	err = k.UpdateParams(ctx, admin, Params{InterestRate: sdk.NewDecWithPrec(105, 2)}, []string{"interest_rate"})
+	assert.Nil(t, err)
+	projection, err := k.ProjectStake(ctx, stakes[0])
+	assert.NoError(t, err)
+	assert.True(t, projection.ArgumentCreatorReward.Amount.IsZero())
+}
+
+func TestKeeper_ClosedClaim(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr3 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	newClaim := func(id uint64, status claim.ClaimStatus) claim.Claim {
+		return claim.Claim{
+			ID:              id,
+			CommunityID:     "testunit",
+			Status:          status,
+			TotalBacked:     sdk.NewInt64Coin(app.StakeDenom, 0),
+			TotalChallenged: sdk.NewInt64Coin(app.StakeDenom, 0),
+		}
+	}
+	claims := map[uint64]claim.Claim{1: newClaim(1, claim.StatusOpen)}
+	mockedClaimKeeper.SetClaims(claims)
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+
+	// locked claims don't take new arguments but can still be voted on
+	claims[1] = newClaim(1, claim.StatusLocked)
+	_, err = k.SubmitArgument(ctx, "body", "summary", addr2, 1, StakeChallenge)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeClaimClosed, err.Code())
+	_, err = k.SubmitUpvote(ctx, argument.ID, addr2)
+	assert.NoError(t, err)
+
+	claims[1] = newClaim(1, claim.StatusResolved)
+	_, err = k.SubmitUpvote(ctx, argument.ID, addr3)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeClaimClosed, err.Code())
+}
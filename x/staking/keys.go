@@ -13,20 +13,30 @@ var (
 	ArgumentsKeyPrefix   = []byte{0x01}
 	EarnedCoinsKeyPrefix = []byte{0x02}
 
+	StakeLimitUpgradesKeyPrefix     = []byte{0x03}
+	CommunityStakingParamsKeyPrefix = []byte{0x04}
+	UnjailUpvotesKeyPrefix          = []byte{0x05}
+	ArgumentRevisionsKeyPrefix      = []byte{0x06}
+	EarningsBucketsKeyPrefix        = []byte{0x07}
+
 	// ID Keys
 	StakeIDKey    = []byte{0x10}
 	ArgumentIDKey = []byte{0x11}
 
 	// AssociationKeys
-	ClaimArgumentsKeyPrefix      = []byte{0x20}
-	ArgumentStakesKeyPrefix      = []byte{0x21}
-	UserArgumentsKeyPrefix       = []byte{0x22}
-	UserStakesKeyPrefix          = []byte{0x23}
-	CommunityStakesKeyPrefix     = []byte{0x24}
-	UserCommunityStakesKeyPrefix = []byte{0x25}
+	ClaimArgumentsKeyPrefix       = []byte{0x20}
+	ArgumentStakesKeyPrefix       = []byte{0x21}
+	UserArgumentsKeyPrefix        = []byte{0x22}
+	UserStakesKeyPrefix           = []byte{0x23}
+	CommunityStakesKeyPrefix      = []byte{0x24}
+	UserCommunityStakesKeyPrefix  = []byte{0x25}
+	ArgumentRepliesKeyPrefix      = []byte{0x26}
+	CommunityLeaderboardKeyPrefix = []byte{0x27}
+	CommunityEarningsKeyPrefix    = []byte{0x28}
 
 	// Queue
-	ActiveStakeQueuePrefix = []byte{0x40}
+	ActiveStakeQueuePrefix      = []byte{0x40}
+	FailedStakePayoutsKeyPrefix = []byte{0x41}
 )
 
 // stakeKey gets a key for a stake.
@@ -46,6 +56,52 @@ func userEarnedCoinsKey(user sdk.AccAddress) []byte {
 	return append(EarnedCoinsKeyPrefix, user.Bytes()...)
 }
 
+// 0x03<user>
+func userStakeLimitUpgradesPrefix(user sdk.AccAddress) []byte {
+	return append(StakeLimitUpgradesKeyPrefix, user.Bytes()...)
+}
+
+// 0x03<user><upgraded_time><tier>
+func userStakeLimitUpgradeKey(user sdk.AccAddress, upgradedTime time.Time, tier int) []byte {
+	bz := append(userStakeLimitUpgradesPrefix(user), sdk.FormatTimeBytes(upgradedTime)...)
+	return append(bz, sdk.Uint64ToBigEndian(uint64(tier))...)
+}
+
+// 0x04<community_id>
+func communityStakingParamsKey(communityID string) []byte {
+	return append(CommunityStakingParamsKeyPrefix, []byte(communityID)...)
+}
+
+// 0x05<user>
+func unjailUpvotesKey(user sdk.AccAddress) []byte {
+	return append(UnjailUpvotesKeyPrefix, user.Bytes()...)
+}
+
+// 0x06<argument_id>
+func argumentRevisionsPrefix(argumentID uint64) []byte {
+	return buildKey(ArgumentRevisionsKeyPrefix, argumentID)
+}
+
+// 0x06<argument_id><revision>
+func argumentRevisionKey(argumentID uint64, revision int) []byte {
+	return append(argumentRevisionsPrefix(argumentID), sdk.Uint64ToBigEndian(uint64(revision))...)
+}
+
+// 0x07<user>
+func userEarningsBucketsPrefix(user sdk.AccAddress) []byte {
+	return append(EarningsBucketsKeyPrefix, user.Bytes()...)
+}
+
+// 0x07<user><day>
+func userEarningsDayPrefix(user sdk.AccAddress, day time.Time) []byte {
+	return append(userEarningsBucketsPrefix(user), sdk.FormatTimeBytes(day)...)
+}
+
+// 0x07<user><day><community_id>
+func earningsBucketKey(user sdk.AccAddress, day time.Time, communityID string) []byte {
+	return append(userEarningsDayPrefix(user, day), []byte(communityID)...)
+}
+
 func splitKeyWithAddress(key []byte) (addr sdk.AccAddress) {
 	if len(key[1:]) != sdk.AddrLen {
 		panic(fmt.Sprintf("unexpected key length (%d ≠ %d)", len(key), 8+sdk.AddrLen))
@@ -134,6 +190,60 @@ func userCommunityStakeKey(creator sdk.AccAddress, communityID string, stakeID u
 	return append(userCommunityStakesPrefix(creator, communityID), bz...)
 }
 
+// argumentRepliesPrefix
+// 0x26<parent_argument_id>
+func argumentRepliesPrefix(parentArgumentID uint64) []byte {
+	return buildKey(ArgumentRepliesKeyPrefix, parentArgumentID)
+}
+
+// argumentReplyKey builds the key for parent argument->reply association
+// 0x26<parent_argument_id><argument_id>
+func argumentReplyKey(parentArgumentID, argumentID uint64) []byte {
+	bz := sdk.Uint64ToBigEndian(argumentID)
+	return append(argumentRepliesPrefix(parentArgumentID), bz...)
+}
+
+// communityPrefix length prefixes a community id so a community's keys don't share a prefix with another's
+// <prefix><community_id_length><community_id>
+func communityPrefix(prefix []byte, communityID string) []byte {
+	bz := append(prefix, byte(len(communityID)))
+	return append(bz, []byte(communityID)...)
+}
+
+// leaderboardAmountLen fits the 256 bits of an sdk.Int
+const leaderboardAmountLen = 32
+
+// leaderboardAmountBytes encodes a non negative amount so keys sort by amount
+func leaderboardAmountBytes(amount sdk.Int) []byte {
+	bz := make([]byte, leaderboardAmountLen)
+	b := amount.BigInt().Bytes()
+	copy(bz[leaderboardAmountLen-len(b):], b)
+	return bz
+}
+
+// 0x27<community_id_length><community_id>
+func communityLeaderboardPrefix(communityID string) []byte {
+	return communityPrefix(CommunityLeaderboardKeyPrefix, communityID)
+}
+
+// communityLeaderboardKey builds the key ordering the users of a community by earned amount
+// 0x27<community_id_length><community_id><earned><user>
+func communityLeaderboardKey(communityID string, earned sdk.Int, user sdk.AccAddress) []byte {
+	bz := append(communityLeaderboardPrefix(communityID), leaderboardAmountBytes(earned)...)
+	return append(bz, user.Bytes()...)
+}
+
+// 0x28<community_id_length><community_id><day>
+func communityEarningsDayPrefix(communityID string, day time.Time) []byte {
+	return append(communityPrefix(CommunityEarningsKeyPrefix, communityID), sdk.FormatTimeBytes(day)...)
+}
+
+// communityEarningsKey builds the key for community->earnings bucket association
+// 0x28<community_id_length><community_id><day><user>
+func communityEarningsKey(communityID string, day time.Time, user sdk.AccAddress) []byte {
+	return append(communityEarningsDayPrefix(communityID, day), user.Bytes()...)
+}
+
 // activeStakeQueueKey
 // 0x40<end_time><stake_id>
 func activeStakeQueueKey(stakeID uint64, endTime time.Time) []byte {
@@ -146,6 +256,12 @@ func activeStakeByTimeKey(endTime time.Time) []byte {
 	return append(ActiveStakeQueuePrefix, sdk.FormatTimeBytes(endTime)...)
 }
 
+// failedStakePayoutKey
+// 0x41<stake_id>
+func failedStakePayoutKey(stakeID uint64) []byte {
+	return buildKey(FailedStakePayoutsKeyPrefix, stakeID)
+}
+
 func buildKey(prefix []byte, id uint64) []byte {
 	bz := sdk.Uint64ToBigEndian(id)
 	return append(prefix, bz...)
@@ -0,0 +1,140 @@
+package staking
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LeaderboardEntry is the rank of a user in a community by earned amount, starting at 1
+type LeaderboardEntry struct {
+	Rank    int            `json:"rank"`
+	Address sdk.AccAddress `json:"address"`
+	Earned  sdk.Int        `json:"earned"`
+}
+
+// CommunityLeaderboard lists the top earners of a community.
+// Days is 0 for the all time leaderboard, otherwise earnings are summed over the last days including today.
+// UserEntry holds the rank of the requested user, it is nil when the user hasn't earned anything.
+type CommunityLeaderboard struct {
+	CommunityID string             `json:"community_id"`
+	Days        int                `json:"days"`
+	Entries     []LeaderboardEntry `json:"entries"`
+	UserEntry   *LeaderboardEntry  `json:"user_entry,omitempty"`
+}
+
+// updateLeaderboards moves a user in the leaderboard of every community where the earned amount changed
+func (k Keeper) updateLeaderboards(ctx sdk.Context, user sdk.AccAddress, previous, current sdk.Coins) {
+	for _, coin := range previous {
+		if !current.AmountOf(coin.Denom).Equal(coin.Amount) {
+			k.store(ctx).Delete(communityLeaderboardKey(coin.Denom, coin.Amount, user))
+		}
+	}
+	for _, coin := range current {
+		if !coin.Amount.IsPositive() || previous.AmountOf(coin.Denom).Equal(coin.Amount) {
+			continue
+		}
+		k.store(ctx).Set(communityLeaderboardKey(coin.Denom, coin.Amount, user), user.Bytes())
+	}
+}
+
+// IterateCommunityLeaderboard iterates over the users of a community by all time earned amount, highest first
+func (k Keeper) IterateCommunityLeaderboard(ctx sdk.Context, communityID string,
+	cb func(user sdk.AccAddress, earned sdk.Int) (stop bool)) {
+	prefix := communityLeaderboardPrefix(communityID)
+	iterator := sdk.KVStoreReversePrefixIterator(k.store(ctx), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		amount := iterator.Key()[len(prefix) : len(prefix)+leaderboardAmountLen]
+		earned := sdk.NewIntFromBigInt(new(big.Int).SetBytes(amount))
+		if cb(sdk.AccAddress(iterator.Value()), earned) {
+			break
+		}
+	}
+}
+
+// setCommunityEarnings sets a community <-> earnings bucket association in the store
+func (k Keeper) setCommunityEarnings(ctx sdk.Context, bucket EarningsBucket) {
+	k.store(ctx).Set(
+		communityEarningsKey(bucket.CommunityID, bucket.Day, bucket.Address),
+		earningsBucketKey(bucket.Address, bucket.Day, bucket.CommunityID),
+	)
+}
+
+// IterateCommunityEarningsBuckets iterates over the earnings buckets of a community from the day of from until the day of to
+func (k Keeper) IterateCommunityEarningsBuckets(ctx sdk.Context, communityID string, from, to time.Time,
+	cb func(bucket EarningsBucket) (stop bool)) {
+	iterator := k.store(ctx).Iterator(
+		communityEarningsDayPrefix(communityID, earningsDay(from)),
+		communityEarningsDayPrefix(communityID, earningsDay(to).AddDate(0, 0, 1)),
+	)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bucket EarningsBucket
+		k.codec.MustUnmarshalBinaryLengthPrefixed(k.store(ctx).Get(iterator.Value()), &bucket)
+		if cb(bucket) {
+			break
+		}
+	}
+}
+
+// CommunityLeaderboard returns the top earners of a community, all time when days is 0
+// or over the last days otherwise, along with the rank of user when it is set
+func (k Keeper) CommunityLeaderboard(ctx sdk.Context, communityID string, limit, days int, user sdk.AccAddress) CommunityLeaderboard {
+	leaderboard := CommunityLeaderboard{
+		CommunityID: communityID,
+		Days:        days,
+		Entries:     make([]LeaderboardEntry, 0),
+	}
+	rank := 0
+	add := func(address sdk.AccAddress, earned sdk.Int) bool {
+		rank++
+		entry := LeaderboardEntry{Rank: rank, Address: address, Earned: earned}
+		if rank <= limit {
+			leaderboard.Entries = append(leaderboard.Entries, entry)
+		}
+		if !user.Empty() && user.Equals(address) {
+			leaderboard.UserEntry = &entry
+		}
+		// keep going past the top until the user is found
+		return rank >= limit && (user.Empty() || leaderboard.UserEntry != nil)
+	}
+	if days == 0 {
+		k.IterateCommunityLeaderboard(ctx, communityID, add)
+		return leaderboard
+	}
+
+	to := ctx.BlockHeader().Time
+	from := earningsDay(to).AddDate(0, 0, 1-days)
+	earnings := make(map[string]sdk.Int)
+	k.IterateCommunityEarningsBuckets(ctx, communityID, from, to, func(bucket EarningsBucket) bool {
+		net, ok := earnings[string(bucket.Address)]
+		if !ok {
+			net = sdk.ZeroInt()
+		}
+		earnings[string(bucket.Address)] = net.Add(bucket.Earned).Sub(bucket.Deducted)
+		return false
+	})
+	ranked := make([]LeaderboardEntry, 0, len(earnings))
+	for address, earned := range earnings {
+		if earned.IsPositive() {
+			ranked = append(ranked, LeaderboardEntry{Address: sdk.AccAddress(address), Earned: earned})
+		}
+	}
+	// same order as the all time index: highest amount first, then highest address
+	sort.Slice(ranked, func(i, j int) bool {
+		if !ranked[i].Earned.Equal(ranked[j].Earned) {
+			return ranked[i].Earned.GT(ranked[j].Earned)
+		}
+		return bytes.Compare(ranked[i].Address, ranked[j].Address) > 0
+	})
+	for _, entry := range ranked {
+		if add(entry.Address, entry.Earned) {
+			break
+		}
+	}
+	return leaderboard
+}
@@ -0,0 +1,103 @@
+package staking
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestKeeper_CommunityLeaderboard(t *testing.T) {
+	ctx, k, _ := mockDB()
+	_, _, addr1 := keyPubAddr()
+	_, _, addr2 := keyPubAddr()
+	_, _, addr3 := keyPubAddr()
+
+	earn := func(day string, user sdk.AccAddress, communityID string, amount int64) {
+		k.addEarnedCoin(ctx.WithBlockTime(mustParseTime(day)), user, communityID, sdk.NewInt(amount))
+	}
+	earn("2019-07-01", addr1, "crypto", 50)
+	earn("2019-07-01", addr2, "crypto", 30)
+	earn("2019-07-01", addr3, "random", 100)
+	earn("2019-07-05", addr2, "crypto", 10)
+	earn("2019-07-06", addr3, "crypto", 20)
+	ctx = ctx.WithBlockTime(mustParseTime("2019-07-06"))
+
+	leaderboard := k.CommunityLeaderboard(ctx, "crypto", 2, 0, addr3)
+	assert.Len(t, leaderboard.Entries, 2)
+	assert.Equal(t, LeaderboardEntry{Rank: 1, Address: addr1, Earned: sdk.NewInt(50)}, leaderboard.Entries[0])
+	assert.Equal(t, LeaderboardEntry{Rank: 2, Address: addr2, Earned: sdk.NewInt(40)}, leaderboard.Entries[1])
+	assert.Equal(t, &LeaderboardEntry{Rank: 3, Address: addr3, Earned: sdk.NewInt(20)}, leaderboard.UserEntry)
+
+	// deductions move users down the leaderboard
+	k.SubtractEarnedCoin(ctx, addr1, "crypto", sdk.NewInt(45))
+	leaderboard = k.CommunityLeaderboard(ctx, "crypto", 10, 0, addr1)
+	assert.Len(t, leaderboard.Entries, 3)
+	assert.Equal(t, addr2, leaderboard.Entries[0].Address)
+	assert.Equal(t, addr3, leaderboard.Entries[1].Address)
+	assert.Equal(t, &LeaderboardEntry{Rank: 3, Address: addr1, Earned: sdk.NewInt(5)}, leaderboard.UserEntry)
+	k.SubtractEarnedCoin(ctx, addr1, "crypto", sdk.NewInt(5))
+	leaderboard = k.CommunityLeaderboard(ctx, "crypto", 10, 0, addr1)
+	assert.Len(t, leaderboard.Entries, 2)
+	assert.Nil(t, leaderboard.UserEntry)
+
+	// the rolling window only sums the last days, net of deductions
+	leaderboard = k.CommunityLeaderboard(ctx, "crypto", 10, 2, nil)
+	assert.Equal(t, []LeaderboardEntry{
+		{Rank: 1, Address: addr3, Earned: sdk.NewInt(20)},
+		{Rank: 2, Address: addr2, Earned: sdk.NewInt(10)},
+	}, leaderboard.Entries)
+	leaderboard = k.CommunityLeaderboard(ctx, "crypto", 10, 7, addr1)
+	assert.Len(t, leaderboard.Entries, 2)
+	assert.Equal(t, addr2, leaderboard.Entries[0].Address)
+	assert.Nil(t, leaderboard.UserEntry)
+
+	leaderboard = k.CommunityLeaderboard(ctx, "random", 10, 0, nil)
+	assert.Equal(t, []LeaderboardEntry{{Rank: 1, Address: addr3, Earned: sdk.NewInt(100)}}, leaderboard.Entries)
+	// community ids sharing a prefix don't share a leaderboard
+	assert.Len(t, k.CommunityLeaderboard(ctx, "rand", 10, 0, nil).Entries, 0)
+	assert.Len(t, k.CommunityLeaderboard(ctx, "rand", 10, 7, nil).Entries, 0)
+}
+
+func TestQuerier_CommunityLeaderboard(t *testing.T) {
+	ctx, k, _ := mockDB()
+	ctx = ctx.WithBlockTime(mustParseTime("2019-07-01"))
+	_, _, addr1 := keyPubAddr()
+	_, _, addr2 := keyPubAddr()
+	k.addEarnedCoin(ctx, addr1, "crypto", sdk.NewInt(10))
+	k.addEarnedCoin(ctx, addr2, "crypto", sdk.NewInt(20))
+
+	querier := NewQuerier(k)
+	query := func(params QueryCommunityLeaderboardParams) (CommunityLeaderboard, sdk.Error) {
+		req := abci.RequestQuery{
+			Path: strings.Join([]string{"custom", QuerierRoute, QueryCommunityLeaderboard}, "/"),
+			Data: k.codec.MustMarshalJSON(&params),
+		}
+		leaderboard := CommunityLeaderboard{}
+		bz, err := querier(ctx, []string{QueryCommunityLeaderboard}, req)
+		if err != nil {
+			return leaderboard, err
+		}
+		k.codec.MustUnmarshalJSON(bz, &leaderboard)
+		return leaderboard, nil
+	}
+
+	leaderboard, err := query(QueryCommunityLeaderboardParams{CommunityID: "crypto", Limit: 1, Address: addr1})
+	assert.NoError(t, err)
+	assert.Len(t, leaderboard.Entries, 1)
+	assert.Equal(t, addr2, leaderboard.Entries[0].Address)
+	assert.Equal(t, 2, leaderboard.UserEntry.Rank)
+
+	leaderboard, err = query(QueryCommunityLeaderboardParams{CommunityID: "crypto", Days: 1})
+	assert.NoError(t, err)
+	assert.Len(t, leaderboard.Entries, 2)
+
+	_, err = query(QueryCommunityLeaderboardParams{})
+	assert.Error(t, err)
+	_, err = query(QueryCommunityLeaderboardParams{CommunityID: "crypto", Limit: MaxPageLimit + 1})
+	assert.Error(t, err)
+	_, err = query(QueryCommunityLeaderboardParams{CommunityID: "crypto", Days: -1})
+	assert.Error(t, err)
+}
@@ -9,29 +9,44 @@ import (
 // verify interface at compile time
 var _ sdk.Msg = &MsgSubmitArgument{}
 var _ sdk.Msg = &MsgSubmitUpvote{}
+var _ sdk.Msg = &MsgSubmitDownvote{}
 var _ sdk.Msg = &MsgDeleteArgument{}
 var _ sdk.Msg = &MsgEditArgument{}
+var _ sdk.Msg = &MsgWithdrawStake{}
 var _ sdk.Msg = &MsgAddAdmin{}
 var _ sdk.Msg = &MsgRemoveAdmin{}
 var _ sdk.Msg = &MsgUpdateParams{}
+var _ sdk.Msg = &MsgUpdateCommunityStakingParams{}
+var _ sdk.Msg = &MsgRemoveCommunityStakingParams{}
+var _ sdk.Msg = &MsgRetryStakePayout{}
+var _ sdk.Msg = &MsgSetStakeAutoRenew{}
 
 const (
 	TypeMsgSubmitArgument = "submit_argument"
 	TypeMsgSubmitUpvote   = "submit_upvote"
+	TypeMsgSubmitDownvote = "submit_downvote"
 	TypeMsgDeleteArgument = "delete_argument"
 	TypeMsgEditArgument   = "edit_argument"
+	TypeMsgWithdrawStake  = "withdraw_stake"
 	TypeMsgAddAdmin       = "add_admin"
 	TypeMsgRemoveAdmin    = "remove_admin"
 	TypeMsgUpdateParams   = "update_params"
+
+	TypeMsgUpdateCommunityStakingParams = "update_community_staking_params"
+	TypeMsgRemoveCommunityStakingParams = "remove_community_staking_params"
+	TypeMsgRetryStakePayout             = "retry_stake_payout"
+	TypeMsgSetStakeAutoRenew            = "set_stake_auto_renew"
 )
 
 // MsgSubmitArgument msg for creating an argument.
 type MsgSubmitArgument struct {
-	ClaimID   uint64         `json:"claim_id"`
-	Summary   string         `json:"summary"`
-	Body      string         `json:"body"`
-	StakeType StakeType      `json:"stake_type"`
-	Creator   sdk.AccAddress `json:"creator"`
+	ClaimID          uint64         `json:"claim_id"`
+	Summary          string         `json:"summary"`
+	Body             string         `json:"body"`
+	StakeType        StakeType      `json:"stake_type"`
+	Creator          sdk.AccAddress `json:"creator"`
+	ParentArgumentID uint64         `json:"parent_argument_id,omitempty"`
+	AutoRenew        bool           `json:"auto_renew,omitempty"`
 }
 
 // NewMsgSubmitArgument returns a new submit argument message.
@@ -44,6 +59,14 @@ func NewMsgSubmitArgument(creator sdk.AccAddress, claimID uint64, summary, body
 		Creator:   creator,
 	}
 }
+
+// NewMsgSubmitReply returns a new submit argument message replying to another argument.
+func NewMsgSubmitReply(creator sdk.AccAddress, claimID, parentArgumentID uint64, summary, body string, stakeType StakeType) MsgSubmitArgument {
+	msg := NewMsgSubmitArgument(creator, claimID, summary, body, stakeType)
+	msg.ParentArgumentID = parentArgumentID
+	return msg
+}
+
 func (MsgSubmitArgument) Route() string {
 	return RouterKey
 }
@@ -86,6 +109,7 @@ func (msg MsgSubmitArgument) GetSigners() []sdk.AccAddress {
 type MsgSubmitUpvote struct {
 	ArgumentID uint64         `json:"argument_id"`
 	Creator    sdk.AccAddress `json:"creator"`
+	AutoRenew  bool           `json:"auto_renew,omitempty"`
 }
 
 func NewMsgSubmitUpvote(creator sdk.AccAddress, argumentID uint64) MsgSubmitUpvote {
@@ -121,12 +145,60 @@ func (msg MsgSubmitUpvote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Creator}
 }
 
+// MsgSubmitDownvote msg for staking against an argument.
+type MsgSubmitDownvote struct {
+	ArgumentID uint64         `json:"argument_id"`
+	Creator    sdk.AccAddress `json:"creator"`
+}
+
+// NewMsgSubmitDownvote returns a new submit downvote message.
+func NewMsgSubmitDownvote(creator sdk.AccAddress, argumentID uint64) MsgSubmitDownvote {
+	return MsgSubmitDownvote{
+		ArgumentID: argumentID,
+		Creator:    creator,
+	}
+}
+
+func (MsgSubmitDownvote) Route() string {
+	return RouterKey
+}
+
+func (MsgSubmitDownvote) Type() string {
+	return TypeMsgSubmitDownvote
+}
+
+func (msg MsgSubmitDownvote) ValidateBasic() sdk.Error {
+	if len(msg.Creator) == 0 {
+		return sdk.ErrInvalidAddress("Must provide a valid address")
+	}
+	return nil
+}
+
+// GetSignBytes gets the bytes for Msg signer to sign on
+func (msg MsgSubmitDownvote) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners gets the signs of the Msg
+func (msg MsgSubmitDownvote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
 // MsgDeleteArgument msg for deleting an argument.
 type MsgDeleteArgument struct {
 	ArgumentID uint64         `json:"argument_id"`
 	Creator    sdk.AccAddress `json:"creator"`
 }
 
+// NewMsgDeleteArgument returns a new delete argument message.
+func NewMsgDeleteArgument(creator sdk.AccAddress, argumentID uint64) MsgDeleteArgument {
+	return MsgDeleteArgument{
+		ArgumentID: argumentID,
+		Creator:    creator,
+	}
+}
+
 func (MsgDeleteArgument) Route() string {
 	return RouterKey
 }
@@ -207,6 +279,96 @@ func (msg MsgEditArgument) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Creator}
 }
 
+// MsgWithdrawStake msg for withdrawing an active stake before it expires.
+type MsgWithdrawStake struct {
+	StakeID uint64         `json:"stake_id"`
+	Creator sdk.AccAddress `json:"creator"`
+}
+
+// NewMsgWithdrawStake returns a new withdraw stake message.
+func NewMsgWithdrawStake(creator sdk.AccAddress, stakeID uint64) MsgWithdrawStake {
+	return MsgWithdrawStake{
+		StakeID: stakeID,
+		Creator: creator,
+	}
+}
+
+func (MsgWithdrawStake) Route() string {
+	return RouterKey
+}
+
+func (MsgWithdrawStake) Type() string {
+	return TypeMsgWithdrawStake
+}
+
+func (msg MsgWithdrawStake) ValidateBasic() sdk.Error {
+	if msg.StakeID == 0 {
+		return ErrCodeUnknownStake(msg.StakeID)
+	}
+	if len(msg.Creator) == 0 {
+		return sdk.ErrInvalidAddress("Must provide a valid address")
+	}
+	return nil
+}
+
+// GetSignBytes gets the bytes for Msg signer to sign on
+func (msg MsgWithdrawStake) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners gets the signs of the Msg
+func (msg MsgWithdrawStake) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
+// MsgSetStakeAutoRenew msg for renewing an active stake when it expires.
+type MsgSetStakeAutoRenew struct {
+	StakeID   uint64         `json:"stake_id"`
+	Creator   sdk.AccAddress `json:"creator"`
+	AutoRenew bool           `json:"auto_renew"`
+	Compound  bool           `json:"compound"`
+}
+
+// NewMsgSetStakeAutoRenew returns a new set stake auto renew message.
+func NewMsgSetStakeAutoRenew(creator sdk.AccAddress, stakeID uint64, autoRenew, compound bool) MsgSetStakeAutoRenew {
+	return MsgSetStakeAutoRenew{
+		StakeID:   stakeID,
+		Creator:   creator,
+		AutoRenew: autoRenew,
+		Compound:  compound,
+	}
+}
+
+func (MsgSetStakeAutoRenew) Route() string {
+	return RouterKey
+}
+
+func (MsgSetStakeAutoRenew) Type() string {
+	return TypeMsgSetStakeAutoRenew
+}
+
+func (msg MsgSetStakeAutoRenew) ValidateBasic() sdk.Error {
+	if msg.StakeID == 0 {
+		return ErrCodeUnknownStake(msg.StakeID)
+	}
+	if len(msg.Creator) == 0 {
+		return sdk.ErrInvalidAddress("Must provide a valid address")
+	}
+	return nil
+}
+
+// GetSignBytes gets the bytes for Msg signer to sign on
+func (msg MsgSetStakeAutoRenew) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners gets the signs of the Msg
+func (msg MsgSetStakeAutoRenew) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
 // MsgAddAdmin defines the message to add a new admin
 type MsgAddAdmin struct {
 	Admin   sdk.AccAddress `json:"admin"`
@@ -332,3 +494,134 @@ func (msg MsgUpdateParams) GetSignBytes() []byte {
 func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Updater)}
 }
+
+// MsgUpdateCommunityStakingParams defines the message to override staking params for a community
+type MsgUpdateCommunityStakingParams struct {
+	CommunityID   string                 `json:"community_id"`
+	Updates       CommunityStakingParams `json:"updates"`
+	UpdatedFields []string               `json:"updated_fields"`
+	Updater       sdk.AccAddress         `json:"updater"`
+}
+
+// NewMsgUpdateCommunityStakingParams returns the message to override staking params for a community
+func NewMsgUpdateCommunityStakingParams(communityID string, updates CommunityStakingParams,
+	updatedFields []string, updater sdk.AccAddress) MsgUpdateCommunityStakingParams {
+	return MsgUpdateCommunityStakingParams{
+		CommunityID:   communityID,
+		Updates:       updates,
+		UpdatedFields: updatedFields,
+		Updater:       updater,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgUpdateCommunityStakingParams) ValidateBasic() sdk.Error {
+	if len(msg.CommunityID) == 0 {
+		return sdk.ErrUnknownRequest("Community ID cannot be empty")
+	}
+	if len(msg.Updater) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Updater.String())
+	}
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgUpdateCommunityStakingParams) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgUpdateCommunityStakingParams) Type() string { return TypeMsgUpdateCommunityStakingParams }
+
+// GetSignBytes implements Msg
+func (msg MsgUpdateCommunityStakingParams) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the updater as the signer.
+func (msg MsgUpdateCommunityStakingParams) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Updater)}
+}
+
+// MsgRemoveCommunityStakingParams defines the message to make a community use the global staking params again
+type MsgRemoveCommunityStakingParams struct {
+	CommunityID string         `json:"community_id"`
+	Remover     sdk.AccAddress `json:"remover"`
+}
+
+// NewMsgRemoveCommunityStakingParams returns the message to remove the staking params overrides of a community
+func NewMsgRemoveCommunityStakingParams(communityID string, remover sdk.AccAddress) MsgRemoveCommunityStakingParams {
+	return MsgRemoveCommunityStakingParams{
+		CommunityID: communityID,
+		Remover:     remover,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRemoveCommunityStakingParams) ValidateBasic() sdk.Error {
+	if len(msg.CommunityID) == 0 {
+		return sdk.ErrUnknownRequest("Community ID cannot be empty")
+	}
+	if len(msg.Remover) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Remover.String())
+	}
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgRemoveCommunityStakingParams) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgRemoveCommunityStakingParams) Type() string { return TypeMsgRemoveCommunityStakingParams }
+
+// GetSignBytes implements Msg
+func (msg MsgRemoveCommunityStakingParams) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the remover as the signer.
+func (msg MsgRemoveCommunityStakingParams) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Remover)}
+}
+
+// MsgRetryStakePayout defines the message to retry paying out a stake from the failed payouts queue
+type MsgRetryStakePayout struct {
+	StakeID uint64         `json:"stake_id"`
+	Admin   sdk.AccAddress `json:"admin"`
+}
+
+// NewMsgRetryStakePayout returns the message to retry paying out a stake
+func NewMsgRetryStakePayout(stakeID uint64, admin sdk.AccAddress) MsgRetryStakePayout {
+	return MsgRetryStakePayout{
+		StakeID: stakeID,
+		Admin:   admin,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRetryStakePayout) ValidateBasic() sdk.Error {
+	if msg.StakeID == 0 {
+		return ErrCodeUnknownStake(msg.StakeID)
+	}
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Admin.String())
+	}
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgRetryStakePayout) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgRetryStakePayout) Type() string { return TypeMsgRetryStakePayout }
+
+// GetSignBytes implements Msg
+func (msg MsgRetryStakePayout) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the admin as the signer.
+func (msg MsgRetryStakePayout) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Admin)}
+}
@@ -67,3 +67,78 @@ func TestMsgRemoveAdmin_InvalidAdmin(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, sdk.ErrInvalidAddress("").Code(), err.Code())
 }
+
+func TestMsgWithdrawStake_Success(t *testing.T) {
+	creator := sdk.AccAddress([]byte{1, 2})
+
+	msg := NewMsgWithdrawStake(creator, 1)
+	err := msg.ValidateBasic()
+	assert.Nil(t, err)
+	assert.Equal(t, ModuleName, msg.Route())
+	assert.Equal(t, TypeMsgWithdrawStake, msg.Type())
+}
+
+func TestMsgWithdrawStake_InvalidStake(t *testing.T) {
+	creator := sdk.AccAddress([]byte{1, 2})
+
+	msg := NewMsgWithdrawStake(creator, 0)
+	err := msg.ValidateBasic()
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeUnknownStake, err.Code())
+}
+
+func TestMsgSetStakeAutoRenew_Success(t *testing.T) {
+	creator := sdk.AccAddress([]byte{1, 2})
+
+	msg := NewMsgSetStakeAutoRenew(creator, 1, true, true)
+	err := msg.ValidateBasic()
+	assert.Nil(t, err)
+	assert.Equal(t, ModuleName, msg.Route())
+	assert.Equal(t, TypeMsgSetStakeAutoRenew, msg.Type())
+}
+
+func TestMsgSetStakeAutoRenew_InvalidStake(t *testing.T) {
+	creator := sdk.AccAddress([]byte{1, 2})
+
+	msg := NewMsgSetStakeAutoRenew(creator, 0, true, false)
+	err := msg.ValidateBasic()
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeUnknownStake, err.Code())
+}
+
+func TestMsgUpdateCommunityStakingParams_InvalidCommunity(t *testing.T) {
+	updater := sdk.AccAddress([]byte{1, 2})
+
+	msg := NewMsgUpdateCommunityStakingParams("", CommunityStakingParams{}, []string{"period"}, updater)
+	err := msg.ValidateBasic()
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeUnknownRequest, err.Code())
+	assert.Equal(t, TypeMsgUpdateCommunityStakingParams, msg.Type())
+}
+
+func TestMsgRemoveCommunityStakingParams_InvalidRemover(t *testing.T) {
+	msg := NewMsgRemoveCommunityStakingParams("crypto", sdk.AccAddress{})
+	err := msg.ValidateBasic()
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeInvalidAddress, err.Code())
+	assert.Equal(t, TypeMsgRemoveCommunityStakingParams, msg.Type())
+}
+
+func TestMsgRetryStakePayout_InvalidStake(t *testing.T) {
+	admin := sdk.AccAddress([]byte{1, 2})
+
+	msg := NewMsgRetryStakePayout(0, admin)
+	err := msg.ValidateBasic()
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeUnknownStake, err.Code())
+	assert.Equal(t, TypeMsgRetryStakePayout, msg.Type())
+}
+
+func TestMsgSubmitDownvote_InvalidCreator(t *testing.T) {
+	msg := NewMsgSubmitDownvote(sdk.AccAddress{}, 1)
+	err := msg.ValidateBasic()
+
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeInvalidAddress, err.Code())
+	assert.Equal(t, TypeMsgSubmitDownvote, msg.Type())
+}
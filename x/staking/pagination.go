@@ -0,0 +1,201 @@
+package staking
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Page limits of the list queries
+const (
+	DefaultPageLimit = 100
+	MaxPageLimit     = 500
+)
+
+// PageRequest pages through an association in key order.
+// Cursor is the association key, without its prefix, of the first item to return.
+// Expired only filters stakes, Type filters stakes by stake type and arguments by the side they take.
+// CountTotal opts into counting every match, which reads the whole association.
+type PageRequest struct {
+	Limit      int        `json:"limit"`
+	Cursor     []byte     `json:"cursor,omitempty"`
+	Reverse    bool       `json:"reverse"`
+	Expired    *bool      `json:"expired,omitempty"`
+	Type       *StakeType `json:"type,omitempty"`
+	CountTotal bool       `json:"count_total,omitempty"`
+}
+
+// StakesPage is a page of stakes.
+// NextCursor is empty once the association is exhausted, Total is only set when requested.
+type StakesPage struct {
+	Stakes     []Stake `json:"stakes"`
+	NextCursor []byte  `json:"next_cursor,omitempty"`
+	Total      int     `json:"total"`
+}
+
+// ArgumentsPage is a page of arguments.
+// NextCursor is empty once the association is exhausted, Total is only set when requested.
+type ArgumentsPage struct {
+	Arguments  []Argument `json:"arguments"`
+	NextCursor []byte     `json:"next_cursor,omitempty"`
+	Total      int        `json:"total"`
+}
+
+// ValidateBasic checks the page request and applies the default limit
+func (p *PageRequest) ValidateBasic() error {
+	if p.Limit < 0 {
+		return fmt.Errorf("limit must not be negative")
+	}
+	if p.Limit == 0 {
+		p.Limit = DefaultPageLimit
+	}
+	if p.Limit > MaxPageLimit {
+		return fmt.Errorf("limit must not be greater than %d", MaxPageLimit)
+	}
+	if p.Type != nil && !p.Type.Valid() {
+		return fmt.Errorf("invalid stake type %d", *p.Type)
+	}
+	return nil
+}
+
+func (p PageRequest) matchStake(stake Stake) bool {
+	if p.Expired != nil && stake.Expired != *p.Expired {
+		return false
+	}
+	if p.Type != nil && stake.Type != *p.Type {
+		return false
+	}
+	return true
+}
+
+func (p PageRequest) matchArgument(argument Argument) bool {
+	if p.Type != nil && argument.StakeType != *p.Type {
+		return false
+	}
+	return true
+}
+
+// pageIDs seeks to the cursor of an association and reads it until the page is full.
+// It returns the matching ids of the requested page and the cursor of the next page.
+func (k Keeper) pageIDs(ctx sdk.Context, prefix []byte, page PageRequest,
+	match func(id uint64) bool) (ids []uint64, nextCursor []byte) {
+	start, end := prefix, sdk.PrefixEndBytes(prefix)
+	if len(page.Cursor) > 0 {
+		cursor := append(append([]byte{}, prefix...), page.Cursor...)
+		if page.Reverse {
+			// the end is exclusive, the cursor itself is the first item of the page
+			end = append(cursor, 0x00)
+		} else {
+			start = cursor
+		}
+	}
+	var iterator sdk.Iterator
+	if page.Reverse {
+		iterator = k.store(ctx).ReverseIterator(start, end)
+	} else {
+		iterator = k.store(ctx).Iterator(start, end)
+	}
+	defer iterator.Close()
+
+	ids = make([]uint64, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		if len(ids) == page.Limit {
+			nextCursor = append([]byte{}, iterator.Key()[len(prefix):]...)
+			break
+		}
+		var id uint64
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &id)
+		if match(id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nextCursor
+}
+
+// countIDs counts the matching ids of a whole association
+func (k Keeper) countIDs(ctx sdk.Context, prefix []byte, match func(id uint64) bool) (total int) {
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var id uint64
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &id)
+		if match(id) {
+			total++
+		}
+	}
+	return total
+}
+
+func (k Keeper) pageStakes(ctx sdk.Context, prefix []byte, page PageRequest) StakesPage {
+	match := func(id uint64) bool {
+		return page.matchStake(k.mustStake(ctx, id))
+	}
+	ids, nextCursor := k.pageIDs(ctx, prefix, page, match)
+	total := 0
+	if page.CountTotal {
+		total = k.countIDs(ctx, prefix, match)
+	}
+	stakes := make([]Stake, 0, len(ids))
+	for _, id := range ids {
+		stakes = append(stakes, k.mustStake(ctx, id))
+	}
+	return StakesPage{Stakes: stakes, NextCursor: nextCursor, Total: total}
+}
+
+func (k Keeper) pageArguments(ctx sdk.Context, prefix []byte, page PageRequest) ArgumentsPage {
+	match := func(id uint64) bool {
+		return page.matchArgument(k.mustArgument(ctx, id))
+	}
+	ids, nextCursor := k.pageIDs(ctx, prefix, page, match)
+	total := 0
+	if page.CountTotal {
+		total = k.countIDs(ctx, prefix, match)
+	}
+	arguments := make([]Argument, 0, len(ids))
+	for _, id := range ids {
+		arguments = append(arguments, k.mustArgument(ctx, id))
+	}
+	return ArgumentsPage{Arguments: arguments, NextCursor: nextCursor, Total: total}
+}
+
+// UserStakesPage returns a page of the stakes of a user, ordered by creation time
+func (k Keeper) UserStakesPage(ctx sdk.Context, address sdk.AccAddress, page PageRequest) StakesPage {
+	return k.pageStakes(ctx, userStakesPrefix(address), page)
+}
+
+// CommunityStakesPage returns a page of the stakes of a community
+func (k Keeper) CommunityStakesPage(ctx sdk.Context, communityID string, page PageRequest) StakesPage {
+	return k.pageStakes(ctx, communityStakesPrefix(communityID), page)
+}
+
+// ArgumentStakesPage returns a page of the stakes of an argument
+func (k Keeper) ArgumentStakesPage(ctx sdk.Context, argumentID uint64, page PageRequest) StakesPage {
+	return k.pageStakes(ctx, argumentStakesPrefix(argumentID), page)
+}
+
+// UserArgumentsPage returns a page of the arguments of a user
+func (k Keeper) UserArgumentsPage(ctx sdk.Context, address sdk.AccAddress, page PageRequest) ArgumentsPage {
+	return k.pageArguments(ctx, userArgumentsPrefix(address), page)
+}
+
+// ClaimArgumentsPage returns a page of the arguments of a claim
+func (k Keeper) ClaimArgumentsPage(ctx sdk.Context, claimID uint64, page PageRequest) ArgumentsPage {
+	return k.pageArguments(ctx, claimArgumentsPrefix(claimID), page)
+}
+
+func (k Keeper) mustStake(ctx sdk.Context, stakeID uint64) Stake {
+	stake, ok := k.Stake(ctx, stakeID)
+	if !ok {
+		panic(fmt.Sprintf("unable to retrieve stake with id %d", stakeID))
+	}
+	return stake
+}
+
+func (k Keeper) mustArgument(ctx sdk.Context, argumentID uint64) Argument {
+	argument, ok := k.Argument(ctx, argumentID)
+	if !ok {
+		panic(fmt.Sprintf("unable to retrieve argument with id %d", argumentID))
+	}
+	return argument
+}
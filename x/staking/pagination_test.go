@@ -0,0 +1,115 @@
+package staking
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	app "github.com/TruStory/truchain/types"
+)
+
+func stakeIDs(stakes []Stake) []uint64 {
+	ids := make([]uint64, 0, len(stakes))
+	for _, s := range stakes {
+		ids = append(ids, s.ID)
+	}
+	return ids
+}
+
+func TestKeeper_UserStakesPage(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(mustParseTime("2019-01-01"))
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	for i := 0; i < 4; i++ {
+		ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Hour))
+		_, err = k.SubmitArgument(ctx, "body", "summary", addr2, 1, StakeChallenge)
+		assert.NoError(t, err)
+	}
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Hour))
+	_, err = k.SubmitUpvote(ctx, argument.ID, addr2)
+	assert.NoError(t, err)
+	assert.NoError(t, k.EndStake(ctx, 2, RewardResultRefunded))
+
+	page := k.UserStakesPage(ctx, addr2, PageRequest{Limit: 2, CountTotal: true})
+	assert.Equal(t, []uint64{2, 3}, stakeIDs(page.Stakes))
+	assert.Equal(t, 5, page.Total)
+	assert.NotNil(t, page.NextCursor)
+
+	page = k.UserStakesPage(ctx, addr2, PageRequest{Limit: 2, Cursor: page.NextCursor})
+	assert.Equal(t, []uint64{4, 5}, stakeIDs(page.Stakes))
+	// counting is opt-in
+	assert.Equal(t, 0, page.Total)
+	page = k.UserStakesPage(ctx, addr2, PageRequest{Limit: 2, Cursor: page.NextCursor})
+	assert.Equal(t, []uint64{6}, stakeIDs(page.Stakes))
+	assert.Nil(t, page.NextCursor)
+
+	page = k.UserStakesPage(ctx, addr2, PageRequest{Limit: 3, Reverse: true})
+	assert.Equal(t, []uint64{6, 5, 4}, stakeIDs(page.Stakes))
+	page = k.UserStakesPage(ctx, addr2, PageRequest{Limit: 3, Reverse: true, Cursor: page.NextCursor})
+	assert.Equal(t, []uint64{3, 2}, stakeIDs(page.Stakes))
+	assert.Nil(t, page.NextCursor)
+
+	expired := false
+	upvote := StakeUpvote
+	page = k.UserStakesPage(ctx, addr2, PageRequest{Limit: 10, Expired: &expired, CountTotal: true})
+	assert.Equal(t, []uint64{3, 4, 5, 6}, stakeIDs(page.Stakes))
+	assert.Equal(t, 4, page.Total)
+	page = k.UserStakesPage(ctx, addr2, PageRequest{Limit: 10, Type: &upvote, CountTotal: true})
+	assert.Equal(t, []uint64{6}, stakeIDs(page.Stakes))
+	assert.Equal(t, 1, page.Total)
+}
+
+func TestQuerier_ClaimArgumentsPage(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	_, err := k.SubmitArgument(ctx, "body1", "summary1", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	arg2, err := k.SubmitArgument(ctx, "body2", "summary2", addr, 1, StakeChallenge)
+	assert.NoError(t, err)
+	arg3, err := k.SubmitArgument(ctx, "body3", "summary3", addr, 1, StakeChallenge)
+	assert.NoError(t, err)
+
+	querier := NewQuerier(k)
+	query := func(params QueryClaimArgumentsParams) (ArgumentsPage, sdk.Error) {
+		req := abci.RequestQuery{
+			Path: strings.Join([]string{"custom", QuerierRoute, QueryClaimArguments}, "/"),
+			Data: k.codec.MustMarshalJSON(&params),
+		}
+		page := ArgumentsPage{}
+		bz, err := querier(ctx, []string{QueryClaimArguments}, req)
+		if err != nil {
+			return page, err
+		}
+		k.codec.MustUnmarshalJSON(bz, &page)
+		return page, nil
+	}
+
+	challenge := StakeChallenge
+	page, err := query(QueryClaimArgumentsParams{ClaimID: 1, Pagination: PageRequest{Limit: 1, Type: &challenge, CountTotal: true}})
+	assert.NoError(t, err)
+	assert.Len(t, page.Arguments, 1)
+	assert.Equal(t, arg2.ID, page.Arguments[0].ID)
+	assert.Equal(t, 2, page.Total)
+
+	page, err = query(QueryClaimArgumentsParams{ClaimID: 1, Pagination: PageRequest{Limit: 1, Type: &challenge, Cursor: page.NextCursor}})
+	assert.NoError(t, err)
+	assert.Len(t, page.Arguments, 1)
+	assert.Equal(t, arg3.ID, page.Arguments[0].ID)
+	assert.Nil(t, page.NextCursor)
+
+	page, err = query(QueryClaimArgumentsParams{ClaimID: 1})
+	assert.NoError(t, err)
+	assert.Len(t, page.Arguments, 3)
+
+	_, err = query(QueryClaimArgumentsParams{ClaimID: 1, Pagination: PageRequest{Limit: MaxPageLimit + 1}})
+	assert.Error(t, err)
+}
@@ -26,6 +26,13 @@ var (
 	ParamKeyStakeLimitDays           = []byte("stakeLimitDays")
 	ParamKeyUnjailUpvotes            = []byte("unjailUpvotes")
 	ParamKeyMaxArgumentsPerClaim     = []byte("maxArgumentsPerClaim")
+	ParamKeyEarlyWithdrawalPenalty   = []byte("earlyWithdrawalPenalty")
+	ParamKeyStakeTiers               = []byte("stakeTiers")
+	ParamKeyMinimumBalance           = []byte("minimumBalance")
+	ParamKeyMaxExpirationsPerBlock   = []byte("maxExpirationsPerBlock")
+	ParamKeyMaxArgumentDepth         = []byte("maxArgumentDepth")
+	ParamKeyMinorityInterestBonus    = []byte("minorityInterestBonus")
+	ParamKeyMajorityInterestDiscount = []byte("majorityInterestDiscount")
 )
 
 type Params struct {
@@ -42,9 +49,17 @@ type Params struct {
 	// deprecated
 	StakeLimitPercent sdk.Dec `json:"stake_limit_percent"`
 	// deprecated
-	StakeLimitDays       time.Duration `json:"stake_limit_days"`
-	UnjailUpvotes        int           `json:"unjail_upvotes"`
-	MaxArgumentsPerClaim int           `json:"max_arguments_per_claim"`
+	StakeLimitDays         time.Duration `json:"stake_limit_days"`
+	UnjailUpvotes          int           `json:"unjail_upvotes"`
+	MaxArgumentsPerClaim   int           `json:"max_arguments_per_claim"`
+	EarlyWithdrawalPenalty sdk.Dec       `json:"early_withdrawal_penalty"`
+	StakeTiers             []StakeTier   `json:"stake_tiers"`
+	MinimumBalance         sdk.Int       `json:"minimum_balance"`
+	MaxExpirationsPerBlock int           `json:"max_expirations_per_block"`
+	MaxArgumentDepth       int           `json:"max_argument_depth"`
+	// interest rate curve on lopsided claims, both 0 pay the same rate on every claim
+	MinorityInterestBonus    sdk.Dec `json:"minority_interest_bonus"`
+	MajorityInterestDiscount sdk.Dec `json:"majority_interest_discount"`
 }
 
 func DefaultParams() Params {
@@ -63,6 +78,26 @@ func DefaultParams() Params {
 		StakeLimitDays:           time.Hour * 24 * 7,
 		UnjailUpvotes:            1,
 		MaxArgumentsPerClaim:     5,
+		EarlyWithdrawalPenalty:   sdk.NewDecWithPrec(10, 2),
+		StakeTiers:               DefaultStakeTiers(),
+		MinimumBalance:           sdk.NewInt(app.Shanev * 50),
+		MaxExpirationsPerBlock:   500,
+		MaxArgumentDepth:         3,
+		MinorityInterestBonus:    sdk.ZeroDec(),
+		MajorityInterestDiscount: sdk.ZeroDec(),
+	}
+}
+
+// DefaultStakeTiers returns the stake limits, sorted by earned threshold.
+// The first tier applies to users who haven't earned anything yet.
+func DefaultStakeTiers() []StakeTier {
+	return []StakeTier{
+		{EarnedThreshold: sdk.NewInt(0), MaxStake: sdk.NewInt(app.Shanev * 500)},
+		{EarnedThreshold: sdk.NewInt(app.Shanev * 10), MaxStake: sdk.NewInt(app.Shanev * 1000)},
+		{EarnedThreshold: sdk.NewInt(app.Shanev * 20), MaxStake: sdk.NewInt(app.Shanev * 1500)},
+		{EarnedThreshold: sdk.NewInt(app.Shanev * 30), MaxStake: sdk.NewInt(app.Shanev * 2000)},
+		{EarnedThreshold: sdk.NewInt(app.Shanev * 40), MaxStake: sdk.NewInt(app.Shanev * 2500)},
+		{EarnedThreshold: sdk.NewInt(app.Shanev * 50), MaxStake: sdk.NewInt(app.Shanev * 3000)},
 	}
 }
 
@@ -82,6 +117,13 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: ParamKeyStakeLimitDays, Value: &p.StakeLimitDays},
 		{Key: ParamKeyUnjailUpvotes, Value: &p.UnjailUpvotes},
 		{Key: ParamKeyMaxArgumentsPerClaim, Value: &p.MaxArgumentsPerClaim},
+		{Key: ParamKeyEarlyWithdrawalPenalty, Value: &p.EarlyWithdrawalPenalty},
+		{Key: ParamKeyStakeTiers, Value: &p.StakeTiers},
+		{Key: ParamKeyMinimumBalance, Value: &p.MinimumBalance},
+		{Key: ParamKeyMaxExpirationsPerBlock, Value: &p.MaxExpirationsPerBlock},
+		{Key: ParamKeyMaxArgumentDepth, Value: &p.MaxArgumentDepth},
+		{Key: ParamKeyMinorityInterestBonus, Value: &p.MinorityInterestBonus},
+		{Key: ParamKeyMajorityInterestDiscount, Value: &p.MajorityInterestDiscount},
 	}
 }
 
@@ -113,11 +155,134 @@ func (k Keeper) UpdateParams(ctx sdk.Context, updater sdk.AccAddress, updates Pa
 
 	current := k.GetParams(ctx)
 	updated := k.getUpdatedParams(current, updates, updatedFields)
+	penalty := updated.EarlyWithdrawalPenalty
+	if penalty.IsNil() || penalty.IsNegative() || penalty.GT(sdk.OneDec()) {
+		return ErrCodeInvalidParams(ErrInvalidEarlyWithdrawalPenalty)
+	}
+	if isNilInt(updated.MinimumBalance) || updated.MinimumBalance.IsNegative() {
+		return ErrCodeInvalidParams(ErrInvalidMinimumBalance)
+	}
+	if updated.MaxExpirationsPerBlock <= 0 {
+		return ErrCodeInvalidParams(ErrInvalidMaxExpirationsPerBlock)
+	}
+	if updated.MaxArgumentDepth < 0 {
+		return ErrCodeInvalidParams(ErrInvalidMaxArgumentDepth)
+	}
+	if err := validateInterestCurve(updated); err != nil {
+		return ErrCodeInvalidParams(err)
+	}
+	if err := validateStakeTiers(updated.StakeTiers); err != nil {
+		return ErrCodeInvalidParams(err)
+	}
 	k.SetParams(ctx, updated)
 
 	return nil
 }
 
+// CommunityParams resolves the staking params for a community, falling back to the global params
+func (k Keeper) CommunityParams(ctx sdk.Context, communityID string) Params {
+	p := k.GetParams(ctx)
+	overrides, ok := k.CommunityStakingParams(ctx, communityID)
+	if !ok {
+		return p
+	}
+	mapParams(overrides, func(param string, index int, field reflect.StructField) {
+		if isIn(param, overrides.Overrides) {
+			reflect.ValueOf(&p).Elem().FieldByName(field.Name).Set(
+				reflect.ValueOf(overrides).FieldByName(field.Name),
+			)
+		}
+	})
+	return p
+}
+
+// CommunityStakingParams returns the staking params overrides of a community
+func (k Keeper) CommunityStakingParams(ctx sdk.Context, communityID string) (CommunityStakingParams, bool) {
+	overrides := CommunityStakingParams{}
+	bz := k.store(ctx).Get(communityStakingParamsKey(communityID))
+	if bz == nil {
+		return overrides, false
+	}
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &overrides)
+	return overrides, true
+}
+
+func (k Keeper) setCommunityStakingParams(ctx sdk.Context, overrides CommunityStakingParams) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(overrides)
+	k.store(ctx).Set(communityStakingParamsKey(overrides.CommunityID), bz)
+}
+
+// AllCommunityStakingParams returns the staking params overrides of every community
+func (k Keeper) AllCommunityStakingParams(ctx sdk.Context) []CommunityStakingParams {
+	all := make([]CommunityStakingParams, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), CommunityStakingParamsKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var overrides CommunityStakingParams
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &overrides)
+		all = append(all, overrides)
+	}
+	return all
+}
+
+// UpdateCommunityStakingParams overrides the given params for a community
+func (k Keeper) UpdateCommunityStakingParams(ctx sdk.Context, updater sdk.AccAddress, communityID string,
+	updates CommunityStakingParams, updatedFields []string) sdk.Error {
+	if !k.isAdmin(ctx, updater) {
+		return ErrAddressNotAuthorised()
+	}
+	for _, param := range updatedFields {
+		if !isIn(param, overridableCommunityParams) {
+			return ErrCodeInvalidParams(ErrUnknownCommunityParam)
+		}
+	}
+
+	overrides, ok := k.CommunityStakingParams(ctx, communityID)
+	if !ok {
+		// start from the global params so every stored field is valid
+		p := k.GetParams(ctx)
+		overrides = CommunityStakingParams{
+			CommunityID:           communityID,
+			Period:                p.Period,
+			ArgumentCreationStake: p.ArgumentCreationStake,
+			UpvoteStake:           p.UpvoteStake,
+			CreatorShare:          p.CreatorShare,
+			InterestRate:          p.InterestRate,
+			Overrides:             []string{},
+		}
+	}
+	mapParams(updates, func(param string, index int, field reflect.StructField) {
+		if !isIn(param, updatedFields) {
+			return
+		}
+		reflect.ValueOf(&overrides).Elem().FieldByName(field.Name).Set(
+			reflect.ValueOf(updates).FieldByName(field.Name),
+		)
+		if !isIn(param, overrides.Overrides) {
+			overrides.Overrides = append(overrides.Overrides, param)
+		}
+	})
+	if err := validateCommunityStakingParams(overrides); err != nil {
+		return ErrCodeInvalidParams(err)
+	}
+	k.setCommunityStakingParams(ctx, overrides)
+
+	return nil
+}
+
+// RemoveCommunityStakingParams makes a community use the global params again
+func (k Keeper) RemoveCommunityStakingParams(ctx sdk.Context, remover sdk.AccAddress, communityID string) sdk.Error {
+	if !k.isAdmin(ctx, remover) {
+		return ErrAddressNotAuthorised()
+	}
+	if _, ok := k.CommunityStakingParams(ctx, communityID); !ok {
+		return ErrCodeUnknownCommunityStakingParams(communityID)
+	}
+	k.store(ctx).Delete(communityStakingParamsKey(communityID))
+
+	return nil
+}
+
 func (k Keeper) getUpdatedParams(current Params, updates Params, updatedFields []string) Params {
 	updated := current
 	mapParams(updates, func(param string, index int, field reflect.StructField) {
@@ -1,25 +1,39 @@
 package staking
 
 import (
+	"fmt"
+	"time"
+
 	app "github.com/TruStory/truchain/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 const (
-	QueryClaimArgument       = "claim_argument"
-	QueryClaimArguments      = "claim_arguments"
-	QueryUserArguments       = "user_arguments"
-	QueryArgumentStakes      = "argument_stakes"
-	QueryCommunityStakes     = "community_stakes"
-	QueryStake               = "stake"
-	QueryArgumentsByIDs      = "arguments_ids"
-	QueryUserStakes          = "user_stakes"
-	QueryUserCommunityStakes = "user_community_stakes"
-	QueryClaimTopArgument    = "claim_top_argument"
-	QueryEarnedCoins         = "earned_coins"
-	QueryTotalEarnedCoins    = "total_earned_coins"
-	QueryParams              = "params"
+	QueryClaimArgument        = "claim_argument"
+	QueryClaimArguments       = "claim_arguments"
+	QueryUserArguments        = "user_arguments"
+	QueryArgumentStakes       = "argument_stakes"
+	QueryCommunityStakes      = "community_stakes"
+	QueryStake                = "stake"
+	QueryArgumentsByIDs       = "arguments_ids"
+	QueryUserStakes           = "user_stakes"
+	QueryUserCommunityStakes  = "user_community_stakes"
+	QueryClaimTopArgument     = "claim_top_argument"
+	QueryEarnedCoins          = "earned_coins"
+	QueryTotalEarnedCoins     = "total_earned_coins"
+	QueryParams               = "params"
+	QueryUserStakeLimit       = "user_stake_limit"
+	QueryStakeLimitUpgrades   = "stake_limit_upgrades"
+	QueryCommunityParams      = "community_params"
+	QueryFailedStakePayouts   = "failed_stake_payouts"
+	QueryStakeProjection      = "stake_projection"
+	QueryUserStakeProjection  = "user_stake_projection"
+	QueryArgumentReplies      = "argument_replies"
+	QueryArgumentRevisions    = "argument_revisions"
+	QueryClaimArgumentsRanked = "claim_arguments_ranked"
+	QueryUserEarningsHistory  = "user_earnings_history"
+	QueryCommunityLeaderboard = "community_leaderboard"
 )
 
 type QueryClaimArgumentParams struct {
@@ -27,19 +41,41 @@ type QueryClaimArgumentParams struct {
 }
 
 type QueryClaimArgumentsParams struct {
-	ClaimID uint64 `json:"claim_id"`
+	ClaimID    uint64      `json:"claim_id"`
+	Pagination PageRequest `json:"pagination"`
+}
+
+type QueryArgumentRepliesParams struct {
+	ArgumentID uint64 `json:"argument_id"`
+}
+
+type QueryArgumentRevisionsParams struct {
+	ArgumentID uint64 `json:"argument_id"`
+}
+
+// QueryClaimArgumentsRankedParams sorts the arguments of a claim by a ranking strategy.
+// StakeType is optional, a limit of 0 returns every argument after the offset.
+type QueryClaimArgumentsRankedParams struct {
+	ClaimID   uint64     `json:"claim_id"`
+	Strategy  string     `json:"strategy"`
+	StakeType *StakeType `json:"stake_type,omitempty"`
+	Limit     int        `json:"limit"`
+	Offset    int        `json:"offset"`
 }
 
 type QueryUserArgumentsParams struct {
-	Address sdk.AccAddress `json:"address"`
+	Address    sdk.AccAddress `json:"address"`
+	Pagination PageRequest    `json:"pagination"`
 }
 
 type QueryArgumentStakesParams struct {
-	ArgumentID uint64 `json:"argument_id"`
+	ArgumentID uint64      `json:"argument_id"`
+	Pagination PageRequest `json:"pagination"`
 }
 
 type QueryCommunityStakesParams struct {
-	CommunityID string `json:"community_id"`
+	CommunityID string      `json:"community_id"`
+	Pagination  PageRequest `json:"pagination"`
 }
 
 type QueryStakeParams struct {
@@ -51,7 +87,8 @@ type QueryArgumentsByIDsParams struct {
 }
 
 type QueryUserStakesParams struct {
-	Address sdk.AccAddress `json:"address"`
+	Address    sdk.AccAddress `json:"address"`
+	Pagination PageRequest    `json:"pagination"`
 }
 
 type QueryUserCommunityStakesParams struct {
@@ -71,6 +108,45 @@ type QueryTotalEarnedCoinsParams struct {
 	Address sdk.AccAddress `json:"address"`
 }
 
+type QueryUserStakeLimitParams struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
+type QueryStakeLimitUpgradesParams struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
+type QueryCommunityParamsParams struct {
+	CommunityID string `json:"community_id"`
+}
+
+type QueryStakeProjectionParams struct {
+	StakeID uint64 `json:"stake_id"`
+}
+
+type QueryUserStakeProjectionParams struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
+// QueryUserEarningsHistoryParams sums the earnings of a user from the day of From until the day of To.
+// CommunityID is optional, Granularity defaults to daily.
+type QueryUserEarningsHistoryParams struct {
+	Address     sdk.AccAddress `json:"address"`
+	CommunityID string         `json:"community_id,omitempty"`
+	From        time.Time      `json:"from"`
+	To          time.Time      `json:"to"`
+	Granularity string         `json:"granularity"`
+}
+
+// QueryCommunityLeaderboardParams ranks the top Limit earners of a community.
+// Days is 0 for all time earnings, Address is optional and adds the rank of that user.
+type QueryCommunityLeaderboardParams struct {
+	CommunityID string         `json:"community_id"`
+	Limit       int            `json:"limit"`
+	Days        int            `json:"days"`
+	Address     sdk.AccAddress `json:"address,omitempty"`
+}
+
 // NewQuerier creates a new querier
 func NewQuerier(keeper Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
@@ -79,6 +155,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryClaimArgument(ctx, req, keeper)
 		case QueryClaimArguments:
 			return queryClaimArguments(ctx, req, keeper)
+		case QueryArgumentReplies:
+			return queryArgumentReplies(ctx, req, keeper)
+		case QueryArgumentRevisions:
+			return queryArgumentRevisions(ctx, req, keeper)
+		case QueryClaimArgumentsRanked:
+			return queryClaimArgumentsRanked(ctx, req, keeper)
 		case QueryUserArguments:
 			return queryUserArguments(ctx, req, keeper)
 		case QueryArgumentStakes:
@@ -101,6 +183,22 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryTotalEarnedCoins(ctx, req, keeper)
 		case QueryParams:
 			return queryParams(ctx, keeper)
+		case QueryUserStakeLimit:
+			return queryUserStakeLimit(ctx, req, keeper)
+		case QueryStakeLimitUpgrades:
+			return queryStakeLimitUpgrades(ctx, req, keeper)
+		case QueryCommunityParams:
+			return queryCommunityParams(ctx, req, keeper)
+		case QueryFailedStakePayouts:
+			return queryFailedStakePayouts(ctx, keeper)
+		case QueryStakeProjection:
+			return queryStakeProjection(ctx, req, keeper)
+		case QueryUserStakeProjection:
+			return queryUserStakeProjection(ctx, req, keeper)
+		case QueryUserEarningsHistory:
+			return queryUserEarningsHistory(ctx, req, keeper)
+		case QueryCommunityLeaderboard:
+			return queryCommunityLeaderboard(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("Unknown staking query endpoint")
 		}
@@ -130,8 +228,12 @@ func queryUserArguments(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 	if err != nil {
 		return nil, ErrInvalidQueryParams(err)
 	}
-	arguments := keeper.UserArguments(ctx, params.Address)
-	bz, err := keeper.codec.MarshalJSON(arguments)
+	err = params.Pagination.ValidateBasic()
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	page := keeper.UserArgumentsPage(ctx, params.Address, params.Pagination)
+	bz, err := keeper.codec.MarshalJSON(page)
 	if err != nil {
 		return nil, ErrJSONParse(err)
 	}
@@ -144,7 +246,74 @@ func queryClaimArguments(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	if err != nil {
 		return nil, ErrInvalidQueryParams(err)
 	}
-	arguments := keeper.ClaimArguments(ctx, params.ClaimID)
+	err = params.Pagination.ValidateBasic()
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	page := keeper.ClaimArgumentsPage(ctx, params.ClaimID, params.Pagination)
+	bz, err := keeper.codec.MarshalJSON(page)
+	if err != nil {
+		return nil, ErrJSONParse(err)
+	}
+	return bz, nil
+}
+
+func queryArgumentReplies(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryArgumentRepliesParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	arguments := keeper.ArgumentReplies(ctx, params.ArgumentID)
+	bz, err := keeper.codec.MarshalJSON(arguments)
+	if err != nil {
+		return nil, ErrJSONParse(err)
+	}
+	return bz, nil
+}
+
+func queryArgumentRevisions(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryArgumentRevisionsParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	if _, ok := keeper.Argument(ctx, params.ArgumentID); !ok {
+		return nil, ErrCodeUnknownArgument(params.ArgumentID)
+	}
+	revisions := keeper.ArgumentRevisions(ctx, params.ArgumentID)
+	bz, err := keeper.codec.MarshalJSON(revisions)
+	if err != nil {
+		return nil, ErrJSONParse(err)
+	}
+	return bz, nil
+}
+
+func queryClaimArgumentsRanked(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryClaimArgumentsRankedParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	if params.Strategy == "" {
+		params.Strategy = RankByTotalStake
+	}
+	ranker, ok := RankerFor(params.Strategy)
+	if !ok {
+		return nil, ErrInvalidQueryParams(fmt.Errorf("unknown ranking strategy %s", params.Strategy))
+	}
+	if params.Limit < 0 || params.Offset < 0 {
+		return nil, ErrInvalidQueryParams(fmt.Errorf("limit and offset must not be negative"))
+	}
+	arguments := keeper.ClaimArgumentsRanked(ctx, params.ClaimID, ranker, params.StakeType)
+	if params.Offset >= len(arguments) {
+		arguments = make([]Argument, 0)
+	} else {
+		arguments = arguments[params.Offset:]
+	}
+	if params.Limit > 0 && params.Limit < len(arguments) {
+		arguments = arguments[:params.Limit]
+	}
 	bz, err := keeper.codec.MarshalJSON(arguments)
 	if err != nil {
 		return nil, ErrJSONParse(err)
@@ -158,8 +327,12 @@ func queryArgumentStakes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	if err != nil {
 		return nil, ErrInvalidQueryParams(err)
 	}
-	stakes := keeper.ArgumentStakes(ctx, params.ArgumentID)
-	bz, err := keeper.codec.MarshalJSON(stakes)
+	err = params.Pagination.ValidateBasic()
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	page := keeper.ArgumentStakesPage(ctx, params.ArgumentID, params.Pagination)
+	bz, err := keeper.codec.MarshalJSON(page)
 	if err != nil {
 		return nil, ErrJSONParse(err)
 	}
@@ -172,8 +345,12 @@ func queryCommunityStakes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	if err != nil {
 		return nil, ErrInvalidQueryParams(err)
 	}
-	stakes := keeper.CommunityStakes(ctx, params.CommunityID)
-	bz, err := keeper.codec.MarshalJSON(stakes)
+	err = params.Pagination.ValidateBasic()
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	page := keeper.CommunityStakesPage(ctx, params.CommunityID, params.Pagination)
+	bz, err := keeper.codec.MarshalJSON(page)
 	if err != nil {
 		return nil, ErrJSONParse(err)
 	}
@@ -227,8 +404,12 @@ func queryUserStakes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]b
 	if err != nil {
 		return nil, ErrInvalidQueryParams(err)
 	}
-	stakes := keeper.UserStakes(ctx, params.Address)
-	bz, err := keeper.codec.MarshalJSON(stakes)
+	err = params.Pagination.ValidateBasic()
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	page := keeper.UserStakesPage(ctx, params.Address, params.Pagination)
+	bz, err := keeper.codec.MarshalJSON(page)
 	if err != nil {
 		return nil, ErrJSONParse(err)
 	}
@@ -310,6 +491,143 @@ func queryTotalEarnedCoins(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 	return bz, nil
 }
 
+func queryUserStakeLimit(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryUserStakeLimitParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	bz, err := keeper.codec.MarshalJSON(keeper.UserStakeLimit(ctx, params.Address))
+	if err != nil {
+		return nil, ErrJSONParse(err)
+	}
+	return bz, nil
+}
+
+func queryStakeLimitUpgrades(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryStakeLimitUpgradesParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	bz, err := keeper.codec.MarshalJSON(keeper.UserStakeLimitUpgrades(ctx, params.Address))
+	if err != nil {
+		return nil, ErrJSONParse(err)
+	}
+	return bz, nil
+}
+
+func queryCommunityParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryCommunityParamsParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	bz, err := keeper.codec.MarshalJSON(keeper.CommunityParams(ctx, params.CommunityID))
+	if err != nil {
+		return nil, ErrJSONParse(err)
+	}
+	return bz, nil
+}
+
+func queryFailedStakePayouts(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := keeper.codec.MarshalJSON(keeper.FailedStakePayouts(ctx))
+	if err != nil {
+		return nil, ErrJSONParse(err)
+	}
+	return bz, nil
+}
+
+func queryStakeProjection(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryStakeProjectionParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	stake, ok := keeper.Stake(ctx, params.StakeID)
+	if !ok {
+		return nil, ErrCodeUnknownStake(params.StakeID)
+	}
+	projection, sdkErr := keeper.ProjectStake(ctx, stake)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	bz, err := keeper.codec.MarshalJSON(projection)
+	if err != nil {
+		return nil, ErrJSONParse(err)
+	}
+	return bz, nil
+}
+
+func queryUserStakeProjection(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryUserStakeProjectionParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	projections, sdkErr := keeper.UserStakeProjections(ctx, params.Address)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	bz, err := keeper.codec.MarshalJSON(projections)
+	if err != nil {
+		return nil, ErrJSONParse(err)
+	}
+	return bz, nil
+}
+
+func queryUserEarningsHistory(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryUserEarningsHistoryParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	if params.Granularity == "" {
+		params.Granularity = EarningsDaily
+	}
+	if !ValidEarningsGranularity(params.Granularity) {
+		return nil, ErrInvalidQueryParams(fmt.Errorf("unknown granularity %s", params.Granularity))
+	}
+	if params.To.IsZero() {
+		params.To = ctx.BlockHeader().Time
+	}
+	if params.To.Before(params.From) {
+		return nil, ErrInvalidQueryParams(fmt.Errorf("from must not be after to"))
+	}
+	history := keeper.UserEarningsHistory(ctx, params.Address, params.CommunityID, params.From, params.To, params.Granularity)
+	bz, err := keeper.codec.MarshalJSON(history)
+	if err != nil {
+		return nil, ErrJSONParse(err)
+	}
+	return bz, nil
+}
+
+func queryCommunityLeaderboard(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryCommunityLeaderboardParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	if params.CommunityID == "" {
+		return nil, ErrInvalidQueryParams(fmt.Errorf("community id is required"))
+	}
+	if params.Limit == 0 {
+		params.Limit = DefaultPageLimit
+	}
+	if params.Limit < 0 || params.Limit > MaxPageLimit {
+		return nil, ErrInvalidQueryParams(fmt.Errorf("limit must be between 1 and %d", MaxPageLimit))
+	}
+	if params.Days < 0 {
+		return nil, ErrInvalidQueryParams(fmt.Errorf("days must not be negative"))
+	}
+	leaderboard := keeper.CommunityLeaderboard(ctx, params.CommunityID, params.Limit, params.Days, params.Address)
+	bz, err := keeper.codec.MarshalJSON(leaderboard)
+	if err != nil {
+		return nil, ErrJSONParse(err)
+	}
+	return bz, nil
+}
+
 func queryParams(ctx sdk.Context, keeper Keeper) (result []byte, err sdk.Error) {
 	params := keeper.GetParams(ctx)
 
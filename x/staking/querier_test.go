@@ -134,6 +134,7 @@ func TestQuerier_CommunityStakes(t *testing.T) {
 	querier := NewQuerier(k)
 	queryParams := QueryCommunityStakesParams{
 		CommunityID: claim1.CommunityID,
+		Pagination:  PageRequest{CountTotal: true},
 	}
 
 	query := abci.RequestQuery{
@@ -145,9 +146,11 @@ func TestQuerier_CommunityStakes(t *testing.T) {
 	bz, err := querier(ctx, []string{QueryCommunityStakes}, query)
 	assert.NoError(t, err)
 
-	var stakes []Stake
-	k.codec.UnmarshalJSON(bz, &stakes)
-	assert.Len(t, stakes, 2)
+	var page StakesPage
+	k.codec.UnmarshalJSON(bz, &page)
+	assert.Len(t, page.Stakes, 2)
+	assert.Equal(t, 2, page.Total)
+	assert.Nil(t, page.NextCursor)
 }
 
 func TestQuerier_UserCommunityStakes(t *testing.T) {
@@ -201,3 +204,163 @@ func TestQueryParams_Success(t *testing.T) {
 	assert.Nil(t, sdkErr)
 	assert.Equal(t, returnedParams, onChainParams)
 }
+
+func TestQuerier_UserStakeLimit(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	k.setEarnedCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("crypto", app.Shanev*15)))
+
+	_, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+
+	querier := NewQuerier(k)
+	queryParams := QueryUserStakeLimitParams{
+		Address: addr,
+	}
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{"custom", QuerierRoute, QueryUserStakeLimit}, "/"),
+		Data: k.codec.MustMarshalJSON(&queryParams),
+	}
+	bz, err := querier(ctx, []string{QueryUserStakeLimit}, query)
+	assert.NoError(t, err)
+	limit := UserStakeLimit{}
+	jsonErr := k.codec.UnmarshalJSON(bz, &limit)
+	assert.NoError(t, jsonErr)
+	assert.Equal(t, 1, limit.Tier)
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*1000), limit.MaxStake)
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50), limit.Staked)
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*950), limit.Remaining)
+}
+
+func TestQuerier_StakeLimitUpgrades(t *testing.T) {
+	ctx, k, _ := mockDB()
+	_, _, addr := keyPubAddr()
+	k.addEarnedCoin(ctx, addr, "crypto", sdk.NewInt(app.Shanev*20))
+
+	querier := NewQuerier(k)
+	queryParams := QueryStakeLimitUpgradesParams{
+		Address: addr,
+	}
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{"custom", QuerierRoute, QueryStakeLimitUpgrades}, "/"),
+		Data: k.codec.MustMarshalJSON(&queryParams),
+	}
+	bz, err := querier(ctx, []string{QueryStakeLimitUpgrades}, query)
+	assert.NoError(t, err)
+	upgrades := make([]StakeLimitUpgrade, 0)
+	jsonErr := k.codec.UnmarshalJSON(bz, &upgrades)
+	assert.NoError(t, jsonErr)
+	assert.Len(t, upgrades, 1)
+	assert.Equal(t, 2, upgrades[0].Tier)
+	assert.Equal(t, addr, upgrades[0].Address)
+}
+
+func TestQuerier_StakeProjection(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(mustParseTime("2019-01-01"))
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	upvote, err := k.SubmitUpvote(ctx, argument.ID, addr2)
+	assert.NoError(t, err)
+
+	querier := NewQuerier(k)
+	midCtx := ctx.WithBlockTime(mustParseTime("2019-01-04"))
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{"custom", QuerierRoute, QueryStakeProjection}, "/"),
+		Data: k.codec.MustMarshalJSON(QueryStakeProjectionParams{StakeID: upvote.ID}),
+	}
+	bz, err := querier(midCtx, []string{QueryStakeProjection}, query)
+	assert.NoError(t, err)
+	upvoteProjection := StakeProjection{}
+	assert.NoError(t, k.codec.UnmarshalJSON(bz, &upvoteProjection))
+	assert.Equal(t, RewardResultUpvoteSplit, upvoteProjection.Type)
+	assert.Equal(t, time.Hour*24*4, upvoteProjection.TimeLeft)
+	// the upvoter only accrues their share of the interest
+	_, accrued := k.splitReward(ctx, "testunit", k.interest(ctx, "testunit", upvote.Amount, time.Hour*24*3))
+	assert.Equal(t, accrued, upvoteProjection.Accrued.Amount)
+	assert.True(t, upvoteProjection.Accrued.IsLT(upvoteProjection.StakeCreatorReward))
+
+	query = abci.RequestQuery{
+		Path: strings.Join([]string{"custom", QuerierRoute, QueryUserStakeProjection}, "/"),
+		Data: k.codec.MustMarshalJSON(QueryUserStakeProjectionParams{Address: addr}),
+	}
+	bz, err = querier(midCtx, []string{QueryUserStakeProjection}, query)
+	assert.NoError(t, err)
+	userProjections := UserStakeProjections{}
+	assert.NoError(t, k.codec.UnmarshalJSON(bz, &userProjections))
+	assert.Len(t, userProjections.Projections, 1)
+	argumentProjection := userProjections.Projections[0]
+	assert.Equal(t, RewardResultArgumentCreation, argumentProjection.Type)
+	assert.Equal(t, argumentProjection.ArgumentCreatorReward, userProjections.ProjectedReward)
+
+	// projections match the rewards paid out at expiration
+	EndBlocker(ctx.WithBlockTime(mustParseTime("2019-01-08")), k)
+	stake, ok := k.Stake(ctx, argumentProjection.StakeID)
+	assert.True(t, ok)
+	assert.Equal(t, stake.Result.ArgumentCreatorReward, argumentProjection.ArgumentCreatorReward)
+	stake, ok = k.Stake(ctx, upvote.ID)
+	assert.True(t, ok)
+	assert.Equal(t, stake.Result.ArgumentCreatorReward, upvoteProjection.ArgumentCreatorReward)
+	assert.Equal(t, stake.Result.StakeCreatorReward, upvoteProjection.StakeCreatorReward)
+
+	query.Data = k.codec.MustMarshalJSON(QueryStakeProjectionParams{StakeID: upvote.ID})
+	_, err = querier(ctx, []string{QueryStakeProjection}, query)
+	assert.Error(t, err)
+}
+
+func TestQuerier_ArgumentReplies(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	reply, err := k.SubmitReply(ctx, "reply", "summary", addr2, 1, argument.ID, StakeChallenge)
+	assert.NoError(t, err)
+
+	querier := NewQuerier(k)
+	queryParams := QueryArgumentRepliesParams{
+		ArgumentID: argument.ID,
+	}
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{"custom", QuerierRoute, QueryArgumentReplies}, "/"),
+		Data: k.codec.MustMarshalJSON(&queryParams),
+	}
+	bz, err := querier(ctx, []string{QueryArgumentReplies}, query)
+	assert.NoError(t, err)
+	replies := make([]Argument, 0)
+	jsonErr := k.codec.UnmarshalJSON(bz, &replies)
+	assert.NoError(t, jsonErr)
+	assert.Equal(t, []Argument{reply}, replies)
+}
+
+func TestQuerier_ArgumentRevisions(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	_, err = k.EditArgument(ctx, "edited body", "edited summary", addr, argument.ID)
+	assert.NoError(t, err)
+
+	querier := NewQuerier(k)
+	queryParams := QueryArgumentRevisionsParams{
+		ArgumentID: argument.ID,
+	}
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{"custom", QuerierRoute, QueryArgumentRevisions}, "/"),
+		Data: k.codec.MustMarshalJSON(&queryParams),
+	}
+	bz, err := querier(ctx, []string{QueryArgumentRevisions}, query)
+	assert.NoError(t, err)
+	revisions := make([]ArgumentRevision, 0)
+	jsonErr := k.codec.UnmarshalJSON(bz, &revisions)
+	assert.NoError(t, jsonErr)
+	assert.Equal(t, k.ArgumentRevisions(ctx, argument.ID), revisions)
+	assert.Len(t, revisions, 2)
+
+	queryParams.ArgumentID = 9999
+	query.Data = k.codec.MustMarshalJSON(&queryParams)
+	_, err = querier(ctx, []string{QueryArgumentRevisions}, query)
+	assert.Error(t, err)
+}
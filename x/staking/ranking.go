@@ -0,0 +1,127 @@
+package staking
+
+import (
+	"math"
+	"math/big"
+	"sort"
+	"time"
+
+	app "github.com/TruStory/truchain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Ranking strategies for claim arguments
+const (
+	RankByTotalStake = "total_stake"
+	RankByUpvotes    = "upvotes"
+	RankByHot        = "hot"
+	RankByWilson     = "wilson"
+)
+
+// Ranker scores arguments, arguments with a higher score rank first
+type Ranker interface {
+	Score(argument Argument, now time.Time) float64
+}
+
+// TotalStakeRanker ranks arguments by the total amount staked on them
+type TotalStakeRanker struct{}
+
+// Score implements Ranker
+func (TotalStakeRanker) Score(argument Argument, _ time.Time) float64 {
+	return coinToFloat(argument.TotalStake)
+}
+
+// UpvoteRanker ranks arguments by the number of upvotes they received
+type UpvoteRanker struct{}
+
+// Score implements Ranker
+func (UpvoteRanker) Score(argument Argument, _ time.Time) float64 {
+	return float64(argument.UpvotedCount)
+}
+
+// HotRanker ranks arguments by total stake, decayed by the hours since they were created
+type HotRanker struct {
+	Gravity float64
+}
+
+// Score implements Ranker
+func (r HotRanker) Score(argument Argument, now time.Time) float64 {
+	age := now.Sub(argument.CreatedTime).Hours()
+	if age < 0 {
+		age = 0
+	}
+	return coinToFloat(argument.TotalStake) / math.Pow(age+2, r.Gravity)
+}
+
+// WilsonRanker ranks arguments by the lower bound of the Wilson score confidence interval
+// of their upvotes and downvotes, so a few votes don't outrank many mostly positive votes.
+// Downvotes are both downvote stakes and slashes.
+type WilsonRanker struct {
+	Z float64
+}
+
+// Score implements Ranker
+func (r WilsonRanker) Score(argument Argument, _ time.Time) float64 {
+	n := float64(argument.UpvotedCount + argument.DownvotedCount)
+	if n == 0 {
+		return 0
+	}
+	p := float64(argument.UpvotedCount) / n
+	z2 := r.Z * r.Z
+	return (p + z2/(2*n) - r.Z*math.Sqrt((p*(1-p)+z2/(4*n))/n)) / (1 + z2/n)
+}
+
+var rankers = map[string]Ranker{
+	RankByTotalStake: TotalStakeRanker{},
+	RankByUpvotes:    UpvoteRanker{},
+	RankByHot:        HotRanker{Gravity: 1.8},
+	RankByWilson:     WilsonRanker{Z: 1.96},
+}
+
+// RegisterRanker makes a ranking strategy available to the claim_arguments_ranked query
+func RegisterRanker(strategy string, ranker Ranker) {
+	rankers[strategy] = ranker
+}
+
+// RankerFor returns the ranker of a strategy
+func RankerFor(strategy string) (Ranker, bool) {
+	ranker, ok := rankers[strategy]
+	return ranker, ok
+}
+
+// RankArguments sorts arguments by score, keeping the original order for ties.
+// Unhelpful arguments always rank last.
+func RankArguments(arguments []Argument, ranker Ranker, now time.Time) []Argument {
+	scores := make(map[uint64]float64, len(arguments))
+	for _, argument := range arguments {
+		scores[argument.ID] = ranker.Score(argument, now)
+	}
+	sort.SliceStable(arguments, func(i, j int) bool {
+		if arguments[i].IsUnhelpful != arguments[j].IsUnhelpful {
+			return !arguments[i].IsUnhelpful
+		}
+		return scores[arguments[i].ID] > scores[arguments[j].ID]
+	})
+	return arguments
+}
+
+// ClaimArgumentsRanked returns the arguments of a claim sorted by a ranker,
+// optionally only the ones of a stake type
+func (k Keeper) ClaimArgumentsRanked(ctx sdk.Context, claimID uint64, ranker Ranker, stakeType *StakeType) []Argument {
+	arguments := make([]Argument, 0)
+	k.IterateClaimArguments(ctx, claimID, func(argument Argument) bool {
+		if stakeType != nil && argument.StakeType != *stakeType {
+			return false
+		}
+		arguments = append(arguments, argument)
+		return false
+	})
+	return RankArguments(arguments, ranker, ctx.BlockHeader().Time)
+}
+
+// coinToFloat converts an amount to whole TRU, precise enough for ranking.
+// Amounts beyond int64 are converted without overflowing.
+func coinToFloat(coin sdk.Coin) float64 {
+	f, _ := new(big.Float).SetInt(coinAmount(coin).BigInt()).Float64()
+	return f / app.Shanev
+}
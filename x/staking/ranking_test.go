@@ -0,0 +1,125 @@
+package staking
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	app "github.com/TruStory/truchain/types"
+)
+
+func rankedIDs(arguments []Argument) []uint64 {
+	ids := make([]uint64, 0, len(arguments))
+	for _, a := range arguments {
+		ids = append(ids, a.ID)
+	}
+	return ids
+}
+
+func TestRankArguments(t *testing.T) {
+	now := mustParseTime("2019-01-10")
+	arguments := []Argument{
+		{ID: 1, UpvotedCount: 1, DownvotedCount: 0, CreatedTime: mustParseTime("2019-01-01"),
+			TotalStake: sdk.NewInt64Coin(app.StakeDenom, app.Shanev*200)},
+		{ID: 2, UpvotedCount: 40, DownvotedCount: 10, CreatedTime: mustParseTime("2019-01-05"),
+			TotalStake: sdk.NewInt64Coin(app.StakeDenom, app.Shanev*100)},
+		{ID: 3, UpvotedCount: 5, DownvotedCount: 0, CreatedTime: mustParseTime("2019-01-10"),
+			TotalStake: sdk.NewInt64Coin(app.StakeDenom, app.Shanev*60)},
+		{ID: 4, UpvotedCount: 50, DownvotedCount: 0, IsUnhelpful: true, CreatedTime: mustParseTime("2019-01-10"),
+			TotalStake: sdk.NewInt64Coin(app.StakeDenom, app.Shanev*500)},
+	}
+	rank := func(strategy string) []uint64 {
+		ranker, ok := RankerFor(strategy)
+		assert.True(t, ok)
+		ranked := make([]Argument, len(arguments))
+		copy(ranked, arguments)
+		return rankedIDs(RankArguments(ranked, ranker, now))
+	}
+
+	// unhelpful arguments always rank last
+	assert.Equal(t, []uint64{1, 2, 3, 4}, rank(RankByTotalStake))
+	assert.Equal(t, []uint64{2, 3, 1, 4}, rank(RankByUpvotes))
+	// newer arguments beat older arguments with more stake
+	assert.Equal(t, []uint64{3, 2, 1, 4}, rank(RankByHot))
+	// many mostly positive votes beat a few positive votes
+	assert.Equal(t, []uint64{2, 3, 1, 4}, rank(RankByWilson))
+
+	_, ok := RankerFor("unknown")
+	assert.False(t, ok)
+}
+
+func TestWilsonRanker_Score(t *testing.T) {
+	ranker := WilsonRanker{Z: 1.96}
+	assert.Equal(t, float64(0), ranker.Score(Argument{}, time.Time{}))
+	assert.InDelta(t, 0.2065, ranker.Score(Argument{UpvotedCount: 1}, time.Time{}), 0.0001)
+	assert.True(t, ranker.Score(Argument{UpvotedCount: 10}, time.Time{}) > ranker.Score(Argument{UpvotedCount: 10, DownvotedCount: 5}, time.Time{}))
+}
+
+func TestCoinToFloat(t *testing.T) {
+	assert.Equal(t, float64(0), coinToFloat(sdk.Coin{}))
+	assert.Equal(t, float64(50), coinToFloat(sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50)))
+	// beyond int64 the amount doesn't wrap around
+	huge, ok := sdk.NewIntFromString("100000000000000000000000000")
+	assert.True(t, ok)
+	assert.InEpsilon(t, 1e26/app.Shanev, coinToFloat(sdk.NewCoin(app.StakeDenom, huge)), 1e-9)
+}
+
+func TestQuerier_ClaimArgumentsRanked(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr3 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+
+	arg1, err := k.SubmitArgument(ctx, "body1", "summary1", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	arg2, err := k.SubmitArgument(ctx, "body2", "summary2", addr2, 1, StakeChallenge)
+	assert.NoError(t, err)
+	arg3, err := k.SubmitArgument(ctx, "body3", "summary3", addr3, 1, StakeBacking)
+	assert.NoError(t, err)
+	_, err = k.SubmitUpvote(ctx, arg3.ID, addr)
+	assert.NoError(t, err)
+	_, err = k.SubmitUpvote(ctx, arg2.ID, addr3)
+	assert.NoError(t, err)
+	_, err = k.SubmitUpvote(ctx, arg3.ID, addr2)
+	assert.NoError(t, err)
+
+	querier := NewQuerier(k)
+	query := func(params QueryClaimArgumentsRankedParams) ([]uint64, sdk.Error) {
+		req := abci.RequestQuery{
+			Path: strings.Join([]string{"custom", QuerierRoute, QueryClaimArgumentsRanked}, "/"),
+			Data: k.codec.MustMarshalJSON(&params),
+		}
+		bz, err := querier(ctx, []string{QueryClaimArgumentsRanked}, req)
+		if err != nil {
+			return nil, err
+		}
+		arguments := make([]Argument, 0)
+		k.codec.MustUnmarshalJSON(bz, &arguments)
+		return rankedIDs(arguments), nil
+	}
+
+	ids, err := query(QueryClaimArgumentsRankedParams{ClaimID: 1, Strategy: RankByUpvotes})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{arg3.ID, arg2.ID, arg1.ID}, ids)
+
+	backing := StakeBacking
+	ids, err = query(QueryClaimArgumentsRankedParams{ClaimID: 1, Strategy: RankByTotalStake, StakeType: &backing})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{arg3.ID, arg1.ID}, ids)
+
+	ids, err = query(QueryClaimArgumentsRankedParams{ClaimID: 1, Strategy: RankByTotalStake, Limit: 1, Offset: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{arg2.ID}, ids)
+
+	ids, err = query(QueryClaimArgumentsRankedParams{ClaimID: 1, Offset: 5})
+	assert.NoError(t, err)
+	assert.Len(t, ids, 0)
+
+	_, err = query(QueryClaimArgumentsRankedParams{ClaimID: 1, Strategy: "unknown"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,124 @@
+package staking
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetStakeAutoRenew lets the creator of an active stake renew it when it expires,
+// optionally compounding the interest into the renewed stake
+func (k Keeper) SetStakeAutoRenew(ctx sdk.Context, stakeID uint64, creator sdk.AccAddress, autoRenew, compound bool) (Stake, sdk.Error) {
+	stake, ok := k.Stake(ctx, stakeID)
+	if !ok {
+		return Stake{}, ErrCodeUnknownStake(stakeID)
+	}
+	if !stake.Creator.Equals(creator) {
+		return Stake{}, ErrCodeCannotUpdateStakeWrongCreator(stakeID)
+	}
+	if stake.Expired {
+		return Stake{}, ErrCodeStakeAlreadyExpired(stakeID)
+	}
+	// downvotes earn no interest, they only pay out when the argument is marked unhelpful
+	if stake.Type == StakeDownvote {
+		return Stake{}, ErrCodeInvalidStakeType(stake.Type)
+	}
+	stake.AutoRenew = autoRenew
+	stake.Compound = autoRenew && compound
+	k.setStake(ctx, stake)
+	return stake, nil
+}
+
+// ArgumentCreationStake returns the stake an argument was submitted with
+func (k Keeper) ArgumentCreationStake(ctx sdk.Context, argumentID uint64) (Stake, bool) {
+	creationStake, ok := Stake{}, false
+	k.IterateArgumentStakes(ctx, argumentID, func(stake Stake) bool {
+		creationStake, ok = stake, true
+		return true
+	})
+	return creationStake, ok
+}
+
+// autoRenewStake renews a paid out stake. A stake that can't be renewed stays paid out.
+func (k Keeper) autoRenewStake(ctx sdk.Context, stake Stake) {
+	cacheCtx, write := ctx.CacheContext()
+	renewed, err := k.renewStake(cacheCtx, stake)
+	if err != nil {
+		k.Logger(ctx).Info(fmt.Sprintf("Not renewing stakeID %d: %s", stake.ID, err.Error()))
+		return
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeStakeRenewed,
+			sdk.NewAttribute(AttributeKeyStakeID, fmt.Sprintf("%d", renewed.ID)),
+			sdk.NewAttribute(AttributeKeyPreviousStakeID, fmt.Sprintf("%d", stake.ID)),
+		),
+	)
+}
+
+// renewStake opens a new stake for the principal of an expired stake, plus its interest when compounding.
+// The argument, claim and jail checks of a user submitted stake apply, but the duplicate stake
+// check doesn't since the creator already staked on the argument.
+func (k Keeper) renewStake(ctx sdk.Context, stake Stake) (Stake, sdk.Error) {
+	argument, ok := k.Argument(ctx, stake.ArgumentID)
+	if !ok {
+		return Stake{}, ErrCodeUnknownArgument(stake.ArgumentID)
+	}
+	if argument.Deleted {
+		return Stake{}, ErrCodeArgumentDeleted(argument.ID)
+	}
+	if argument.IsUnhelpful {
+		return Stake{}, ErrCodeArgumentUnhelpful(argument.ID)
+	}
+	err := k.checkJailed(ctx, stake.Creator)
+	if err != nil {
+		return Stake{}, err
+	}
+	claim, ok := k.claimKeeper.Claim(ctx, argument.ClaimID)
+	if !ok {
+		return Stake{}, ErrCodeUnknownClaim(argument.ClaimID)
+	}
+	if !claim.AcceptsStakes() {
+		return Stake{}, ErrCodeClaimClosed(claim)
+	}
+
+	amount := stake.Amount
+	if stake.Compound && stake.Result != nil {
+		switch stake.Result.Type {
+		case RewardResultArgumentCreation:
+			amount = amount.Add(stake.Result.ArgumentCreatorReward)
+		case RewardResultUpvoteSplit:
+			amount = amount.Add(stake.Result.StakeCreatorReward)
+		}
+	}
+	interestRate := k.stakeInterestRate(ctx, claim, argument.StakeType)
+	renewed, err := k.newStake(ctx, amount, stake.Creator, stake.Type, argument.ID, argument.CommunityID, interestRate)
+	if err != nil {
+		return Stake{}, err
+	}
+	renewed.AutoRenew = true
+	renewed.Compound = stake.Compound
+	renewed.PreviousStakeID = stake.ID
+	k.setStake(ctx, renewed)
+
+	// a renewed upvote comes from an existing upvoter, so the upvote count stays the same
+	if renewed.Type == StakeUpvote {
+		argument.UpvotedStake = argument.UpvotedStake.Add(renewed.Amount)
+	}
+	argument.TotalStake = argument.TotalStake.Add(renewed.Amount)
+	argument.UpdatedTime = ctx.BlockHeader().Time
+	k.setArgument(ctx, argument)
+
+	switch {
+	case argument.StakeType == StakeBacking:
+		err = k.claimKeeper.AddBackingStake(ctx, argument.ClaimID, renewed.Amount)
+	case argument.StakeType == StakeChallenge:
+		err = k.claimKeeper.AddChallengeStake(ctx, argument.ClaimID, renewed.Amount)
+	}
+	if err != nil {
+		return Stake{}, err
+	}
+	return renewed, nil
+}
@@ -0,0 +1,119 @@
+package staking
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	app "github.com/TruStory/truchain/types"
+)
+
+func TestKeeper_AutoRenewStake(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(mustParseTime("2019-01-01"))
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	upvote, err := k.SubmitUpvote(ctx, argument.ID, addr2)
+	assert.NoError(t, err)
+	creationStake, ok := k.ArgumentCreationStake(ctx, argument.ID)
+	assert.True(t, ok)
+
+	_, err = k.SetStakeAutoRenew(ctx, creationStake.ID, addr2, true, true)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeCannotUpdateStakeWrongCreator, err.Code())
+	_, err = k.SetStakeAutoRenew(ctx, creationStake.ID, addr, true, true)
+	assert.NoError(t, err)
+	_, err = k.SetStakeAutoRenew(ctx, upvote.ID, addr2, true, false)
+	assert.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(mustParseTime("2019-01-08"))
+	EndBlocker(ctx, k)
+
+	creationStake, _ = k.Stake(ctx, creationStake.ID)
+	upvote, _ = k.Stake(ctx, upvote.ID)
+	assert.True(t, creationStake.Expired)
+	assert.True(t, upvote.Expired)
+
+	userStakes := k.UserStakes(ctx, addr)
+	assert.Len(t, userStakes, 2)
+	renewedCreation := userStakes[1]
+	assert.Equal(t, creationStake.ID, renewedCreation.PreviousStakeID)
+	assert.Equal(t, StakeBacking, renewedCreation.Type)
+	assert.True(t, renewedCreation.AutoRenew)
+	assert.True(t, renewedCreation.Compound)
+	assert.False(t, renewedCreation.Expired)
+	// the interest is compounded into the renewed stake
+	assert.Equal(t, creationStake.Amount.Add(creationStake.Result.ArgumentCreatorReward), renewedCreation.Amount)
+
+	user2Stakes := k.UserStakes(ctx, addr2)
+	assert.Len(t, user2Stakes, 2)
+	renewedUpvote := user2Stakes[1]
+	assert.Equal(t, upvote.ID, renewedUpvote.PreviousStakeID)
+	assert.Equal(t, upvote.Amount, renewedUpvote.Amount)
+	assert.Equal(t, ctx.BlockHeader().Time.Add(k.GetParams(ctx).Period), renewedUpvote.EndTime)
+
+	// the renewed upvote doesn't count as another upvote
+	argument, _ = k.Argument(ctx, argument.ID)
+	assert.Equal(t, 1, argument.UpvotedCount)
+	assert.Equal(t, upvote.Amount.Add(renewedUpvote.Amount), argument.UpvotedStake)
+	total := creationStake.Amount.Add(upvote.Amount).Add(renewedCreation.Amount).Add(renewedUpvote.Amount)
+	assert.Equal(t, total, argument.TotalStake)
+	pool := mdb.supplyKeeper.GetModuleAccount(ctx, UserStakesPoolName).GetCoins()
+	assert.Equal(t, sdk.Coins{renewedCreation.Amount.Add(renewedUpvote.Amount)}, pool)
+
+	// stakes on unhelpful arguments aren't renewed
+	err = k.MarkUnhelpfulArgument(ctx, argument.ID)
+	assert.NoError(t, err)
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(k.GetParams(ctx).Period))
+	EndBlocker(ctx, k)
+	assert.Len(t, k.UserStakes(ctx, addr), 2)
+	assert.Len(t, k.UserStakes(ctx, addr2), 2)
+	renewedUpvote, _ = k.Stake(ctx, renewedUpvote.ID)
+	assert.True(t, renewedUpvote.Expired)
+
+	_, err = k.SetStakeAutoRenew(ctx, renewedUpvote.ID, addr2, false, false)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeStakeAlreadyExpired, err.Code())
+}
+
+func TestHandle_SubmitUpvoteAutoRenew(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	handler := NewHandler(k)
+
+	msg := NewMsgSubmitArgument(addr, 1, "valid summary", "valid body", StakeBacking)
+	msg.AutoRenew = true
+	res := handler(ctx, msg)
+	assert.True(t, res.IsOK())
+	argument := Argument{}
+	ModuleCodec.MustUnmarshalJSON(res.Data, &argument)
+	creationStake, _ := k.ArgumentCreationStake(ctx, argument.ID)
+	assert.True(t, creationStake.AutoRenew)
+	assert.False(t, creationStake.Compound)
+
+	upvoteMsg := NewMsgSubmitUpvote(addr2, argument.ID)
+	upvoteMsg.AutoRenew = true
+	res = handler(ctx, upvoteMsg)
+	assert.True(t, res.IsOK())
+	stake := Stake{}
+	ModuleCodec.MustUnmarshalJSON(res.Data, &stake)
+	assert.True(t, stake.AutoRenew)
+
+	res = handler(ctx, NewMsgSetStakeAutoRenew(addr2, stake.ID, true, true))
+	assert.True(t, res.IsOK())
+	stake, _ = k.Stake(ctx, stake.ID)
+	assert.True(t, stake.Compound)
+
+	res = handler(ctx, NewMsgSetStakeAutoRenew(addr2, stake.ID, false, true))
+	assert.True(t, res.IsOK())
+	stake, _ = k.Stake(ctx, stake.ID)
+	assert.False(t, stake.AutoRenew)
+	assert.False(t, stake.Compound)
+}
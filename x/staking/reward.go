@@ -4,12 +4,13 @@ import (
 	"time"
 
 	app "github.com/TruStory/truchain/types"
+	"github.com/TruStory/truchain/x/claim"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-func (k Keeper) splitReward(ctx sdk.Context, interest sdk.Dec) (creator, staker sdk.Int) {
-	p := k.GetParams(ctx)
+func (k Keeper) splitReward(ctx sdk.Context, communityID string, interest sdk.Dec) (creator, staker sdk.Int) {
+	p := k.CommunityParams(ctx, communityID)
 	creatorShare := interest.Mul(p.CreatorShare)
 	stakerShare := interest.Sub(creatorShare)
 	return creatorShare.RoundInt(), stakerShare.RoundInt()
@@ -20,6 +21,12 @@ type RewardResultType byte
 const (
 	RewardResultArgumentCreation RewardResultType = iota
 	RewardResultUpvoteSplit
+	RewardResultDownvote
+	// stakes ended before their expiration don't pay out any interest,
+	// slashed stakes are the ones lost by their creator
+	RewardResultWithdrawn
+	RewardResultRefunded
+	RewardResultSlashed
 )
 
 type RewardResult struct {
@@ -30,6 +37,118 @@ type RewardResult struct {
 	StakeCreatorReward    sdk.Coin         `json:"stake_creator_reward"`
 }
 
+// StakeProjection is the reward an active stake is expected to pay out when it expires.
+// Accrued is the stake creator's share of the interest earned so far.
+type StakeProjection struct {
+	StakeID               uint64           `json:"stake_id"`
+	Type                  RewardResultType `json:"type"`
+	ArgumentCreator       sdk.AccAddress   `json:"argument_creator"`
+	ArgumentCreatorReward sdk.Coin         `json:"argument_creator_reward"`
+	StakeCreator          sdk.AccAddress   `json:"stake_creator"`
+	StakeCreatorReward    sdk.Coin         `json:"stake_creator_reward"`
+	Accrued               sdk.Coin         `json:"accrued"`
+	EndTime               time.Time        `json:"end_time"`
+	TimeLeft              time.Duration    `json:"time_left"`
+}
+
+// UserStakeProjections aggregates the projected rewards of a user's active stakes
+type UserStakeProjections struct {
+	Address         sdk.AccAddress    `json:"address"`
+	Projections     []StakeProjection `json:"projections"`
+	ProjectedReward sdk.Coin          `json:"projected_reward"`
+	Accrued         sdk.Coin          `json:"accrued"`
+}
+
+// ProjectStake estimates the rewards of an active stake at its end time,
+// splitting them the same way distributeReward does
+func (k Keeper) ProjectStake(ctx sdk.Context, stake Stake) (StakeProjection, sdk.Error) {
+	if stake.Expired {
+		return StakeProjection{}, ErrCodeStakeAlreadyExpired(stake.ID)
+	}
+	argument, ok := k.Argument(ctx, stake.ArgumentID)
+	if !ok {
+		return StakeProjection{}, ErrCodeUnknownArgument(stake.ArgumentID)
+	}
+
+	now := ctx.BlockHeader().Time
+	elapsed := now.Sub(stake.CreatedTime)
+	timeLeft := stake.EndTime.Sub(now)
+	if timeLeft < 0 {
+		elapsed = stake.EndTime.Sub(stake.CreatedTime)
+		timeLeft = 0
+	}
+	if stake.Type == StakeDownvote {
+		return StakeProjection{
+			StakeID:               stake.ID,
+			Type:                  RewardResultDownvote,
+			ArgumentCreator:       argument.Creator,
+			ArgumentCreatorReward: sdk.NewInt64Coin(app.StakeDenom, 0),
+			StakeCreator:          stake.Creator,
+			StakeCreatorReward:    sdk.NewInt64Coin(app.StakeDenom, 0),
+			Accrued:               sdk.NewInt64Coin(app.StakeDenom, 0),
+			EndTime:               stake.EndTime,
+			TimeLeft:              timeLeft,
+		}, nil
+	}
+	interest := k.stakeInterest(ctx, stake, argument.CommunityID, stake.EndTime.Sub(stake.CreatedTime))
+	accrued := k.stakeInterest(ctx, stake, argument.CommunityID, elapsed)
+	projection := StakeProjection{
+		StakeID:         stake.ID,
+		ArgumentCreator: argument.Creator,
+		Accrued:         sdk.NewCoin(app.StakeDenom, accrued.RoundInt()),
+		EndTime:         stake.EndTime,
+		TimeLeft:        timeLeft,
+	}
+	// creator receives 100% interest of his own stake
+	if argument.Creator.Equals(stake.Creator) {
+		projection.Type = RewardResultArgumentCreation
+		projection.ArgumentCreatorReward = sdk.NewCoin(app.StakeDenom, interest.RoundInt())
+		return projection, nil
+	}
+	creatorReward, stakerReward := k.splitReward(ctx, argument.CommunityID, interest)
+	_, stakerAccrued := k.splitReward(ctx, argument.CommunityID, accrued)
+	projection.Type = RewardResultUpvoteSplit
+	projection.Accrued = sdk.NewCoin(app.StakeDenom, stakerAccrued)
+	projection.ArgumentCreatorReward = sdk.NewCoin(app.StakeDenom, creatorReward)
+	projection.StakeCreator = stake.Creator
+	projection.StakeCreatorReward = sdk.NewCoin(app.StakeDenom, stakerReward)
+	return projection, nil
+}
+
+// UserStakeProjections projects every active stake of a user.
+// The projected reward and the accrued interest only count the user's own share of each stake.
+func (k Keeper) UserStakeProjections(ctx sdk.Context, address sdk.AccAddress) (UserStakeProjections, sdk.Error) {
+	projections := UserStakeProjections{
+		Address:         address,
+		Projections:     make([]StakeProjection, 0),
+		ProjectedReward: sdk.NewInt64Coin(app.StakeDenom, 0),
+		Accrued:         sdk.NewInt64Coin(app.StakeDenom, 0),
+	}
+	var err sdk.Error
+	k.IterateUserStakes(ctx, address, func(stake Stake) bool {
+		if stake.Expired {
+			return false
+		}
+		var projection StakeProjection
+		projection, err = k.ProjectStake(ctx, stake)
+		if err != nil {
+			return true
+		}
+		projections.Projections = append(projections.Projections, projection)
+		projections.Accrued = projections.Accrued.Add(projection.Accrued)
+		if projection.Type == RewardResultArgumentCreation {
+			projections.ProjectedReward = projections.ProjectedReward.Add(projection.ArgumentCreatorReward)
+			return false
+		}
+		projections.ProjectedReward = projections.ProjectedReward.Add(projection.StakeCreatorReward)
+		return false
+	})
+	if err != nil {
+		return UserStakeProjections{}, err
+	}
+	return projections, nil
+}
+
 func (k Keeper) distributeReward(ctx sdk.Context, stake Stake) (RewardResult, sdk.Error) {
 	argument, ok := k.Argument(ctx, stake.ArgumentID)
 	if !ok {
@@ -40,29 +159,21 @@ func (k Keeper) distributeReward(ctx sdk.Context, stake Stake) (RewardResult, sd
 		return RewardResult{}, ErrCodeUnknownClaim(claim.ID)
 	}
 
-	// refund
-	var refundType TransactionType
-
-	switch stake.Type {
-	case StakeBacking:
-		refundType = TransactionBackingReturned
-	case StakeChallenge:
-		refundType = TransactionChallengeReturned
-	case StakeUpvote:
-		refundType = TransactionUpvoteReturned
-	default:
-		return RewardResult{}, ErrCodeUnknownStakeType()
-	}
-
-	_, err := k.bankKeeper.AddCoin(ctx, stake.Creator, stake.Amount, stake.ArgumentID,
-		refundType, WithCommunityID(argument.CommunityID),
-		FromModuleAccount(UserStakesPoolName),
-	)
+	err := k.refundStake(ctx, stake, argument.CommunityID)
 	if err != nil {
 		return RewardResult{}, err
 	}
+	// downvoters only get their stake back, they lose the interest
+	// unless the argument is marked unhelpful before the stake expires
+	if stake.Type == StakeDownvote {
+		return RewardResult{Type: RewardResultDownvote,
+			ArgumentCreator:       argument.Creator,
+			ArgumentCreatorReward: sdk.NewInt64Coin(app.StakeDenom, 0),
+			StakeCreator:          stake.Creator,
+			StakeCreatorReward:    sdk.NewInt64Coin(app.StakeDenom, 0)}, nil
+	}
 
-	interest := k.interest(ctx, stake.Amount, stake.EndTime.Sub(stake.CreatedTime))
+	interest := k.stakeInterest(ctx, stake, argument.CommunityID, stake.EndTime.Sub(stake.CreatedTime))
 	// creator receives 100% interest of his own stake
 	if argument.Creator.Equals(stake.Creator) {
 		reward := sdk.NewCoin(app.StakeDenom, interest.RoundInt())
@@ -82,7 +193,7 @@ func (k Keeper) distributeReward(ctx sdk.Context, stake Stake) (RewardResult, sd
 			ArgumentCreator:       argument.Creator,
 			ArgumentCreatorReward: reward}, nil
 	}
-	creatorReward, stakerReward := k.splitReward(ctx, interest)
+	creatorReward, stakerReward := k.splitReward(ctx, argument.CommunityID, interest)
 	creatorRewardCoin := sdk.NewCoin(app.StakeDenom, creatorReward)
 	stakerRewardCoin := sdk.NewCoin(app.StakeDenom, stakerReward)
 	_, err = k.bankKeeper.AddCoin(ctx,
@@ -121,11 +232,60 @@ func (k Keeper) distributeReward(ctx sdk.Context, stake Stake) (RewardResult, sd
 	return rewardResult, nil
 }
 
-func (k Keeper) interest(ctx sdk.Context, amount sdk.Coin, period time.Duration) sdk.Dec {
-	interestRate := k.GetParams(ctx).InterestRate
+func (k Keeper) interest(ctx sdk.Context, communityID string, amount sdk.Coin, period time.Duration) sdk.Dec {
+	interestRate := k.CommunityParams(ctx, communityID).InterestRate
 	return Interest(interestRate, amount, period)
 }
 
+// stakeInterest calculates the interest of a stake at the rate stored on it.
+// A stake without a stored rate uses the current community rate. A stored
+// rate of zero is honoured, so the stake earns nothing.
+func (k Keeper) stakeInterest(ctx sdk.Context, stake Stake, communityID string, period time.Duration) sdk.Dec {
+	if stake.InterestRate.IsNil() {
+		return k.interest(ctx, communityID, stake.Amount, period)
+	}
+	return Interest(stake.InterestRate, stake.Amount, period)
+}
+
+// stakeInterestRate is the rate a new stake on a side of a claim earns
+func (k Keeper) stakeInterestRate(ctx sdk.Context, claim claim.Claim, side StakeType) sdk.Dec {
+	p := k.GetParams(ctx)
+	interestRate := k.CommunityParams(ctx, claim.CommunityID).InterestRate
+	if p.MinorityInterestBonus.IsZero() && p.MajorityInterestDiscount.IsZero() {
+		return interestRate
+	}
+	backed, challenged := coinAmount(claim.TotalBacked), coinAmount(claim.TotalChallenged)
+	if side == StakeChallenge {
+		return ImbalanceInterestRate(interestRate, challenged, backed, p.MinorityInterestBonus, p.MajorityInterestDiscount)
+	}
+	return ImbalanceInterestRate(interestRate, backed, challenged, p.MinorityInterestBonus, p.MajorityInterestDiscount)
+}
+
+// ImbalanceInterestRate adjusts an interest rate by how lopsided a claim is.
+// The imbalance is the difference between the staked totals of both sides over their sum.
+// The minority side earns up to minorityBonus more, the majority side up to majorityDiscount less.
+func ImbalanceInterestRate(interestRate sdk.Dec, side, opposite sdk.Int, minorityBonus, majorityDiscount sdk.Dec) sdk.Dec {
+	total := side.Add(opposite)
+	if total.IsZero() || side.Equal(opposite) {
+		return interestRate
+	}
+	difference := side.Sub(opposite)
+	if difference.IsNegative() {
+		imbalance := sdk.NewDecFromInt(difference.Neg()).QuoInt(total)
+		return interestRate.Mul(sdk.OneDec().Add(minorityBonus.Mul(imbalance)))
+	}
+	imbalance := sdk.NewDecFromInt(difference).QuoInt(total)
+	return interestRate.Mul(sdk.OneDec().Sub(majorityDiscount.Mul(imbalance)))
+}
+
+// coinAmount returns the amount of a coin, claims created before the totals were tracked have none
+func coinAmount(coin sdk.Coin) sdk.Int {
+	if coin.Denom == "" {
+		return sdk.ZeroInt()
+	}
+	return coin.Amount
+}
+
 // Interest takes an annual inflation/interest rate and calculates the return on an amount staked for a given period
 func Interest(interestRate sdk.Dec, amount sdk.Coin, period time.Duration) sdk.Dec {
 	periodDec := sdk.NewDec(period.Nanoseconds())
@@ -1,6 +1,8 @@
 package staking
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"time"
 
@@ -22,6 +24,17 @@ const (
 	EventTypeStakeLimitIncreased  = "stake-limit-increased"
 	AttributeKeyStakeLimitUpgrade = "stake-limit-upgrade"
 
+	EventTypeStakePayoutFailed = "stake-payout-failed"
+	AttributeKeyStakeID        = "stake-id"
+	AttributeKeyError          = "error"
+
+	EventTypeArgumentDeleted = "argument-deleted"
+	AttributeKeyArgumentID   = "argument-id"
+	AttributeKeyClaimID      = "claim-id"
+
+	EventTypeStakeRenewed       = "stake-renewed"
+	AttributeKeyPreviousStakeID = "previous-stake-id"
+
 	UserStakesPoolName = "user_stakes_tokens_pool"
 )
 
@@ -38,18 +51,21 @@ const (
 	StakeBacking StakeType = iota
 	StakeChallenge
 	StakeUpvote
+	StakeDownvote
 )
 
 var StakeTypeName = []string{
 	StakeBacking:   "StakeBacking",
 	StakeChallenge: "StakeChallenge",
 	StakeUpvote:    "StakeUpvote",
+	StakeDownvote:  "StakeDownvote",
 }
 
 var bankTransactionMappings = []TransactionType{
 	StakeBacking:   TransactionBacking,
 	StakeChallenge: TransactionChallenge,
 	StakeUpvote:    TransactionUpvote,
+	StakeDownvote:  TransactionDownvote,
 }
 
 func (t StakeType) BankTransactionType() bank.TransactionType {
@@ -67,8 +83,16 @@ func (t StakeType) ValidForUpvote() bool {
 	return t.oneOf([]StakeType{StakeBacking, StakeChallenge})
 }
 
+// Opposite returns the side a reply to an argument of this type takes
+func (t StakeType) Opposite() StakeType {
+	if t == StakeBacking {
+		return StakeChallenge
+	}
+	return StakeBacking
+}
+
 func (t StakeType) Valid() bool {
-	return t.oneOf([]StakeType{StakeBacking, StakeChallenge, StakeUpvote})
+	return t.oneOf([]StakeType{StakeBacking, StakeChallenge, StakeUpvote, StakeDownvote})
 }
 
 func (t StakeType) oneOf(types []StakeType) bool {
@@ -81,16 +105,22 @@ func (t StakeType) oneOf(types []StakeType) bool {
 }
 
 type Stake struct {
-	ID          uint64         `json:"id"`
-	ArgumentID  uint64         `json:"argument_id"`
-	CommunityID string         `json:"community_id"`
-	Type        StakeType      `json:"type"`
-	Amount      sdk.Coin       `json:"amount"`
-	Creator     sdk.AccAddress `json:"creator"`
-	CreatedTime time.Time      `json:"created_time"`
-	EndTime     time.Time      `json:"end_time"`
-	Expired     bool           `json:"expired"`
-	Result      *RewardResult  `json:"result,omitempty"`
+	ID              uint64         `json:"id"`
+	ArgumentID      uint64         `json:"argument_id"`
+	CommunityID     string         `json:"community_id"`
+	Type            StakeType      `json:"type"`
+	Amount          sdk.Coin       `json:"amount"`
+	Creator         sdk.AccAddress `json:"creator"`
+	CreatedTime     time.Time      `json:"created_time"`
+	EndTime         time.Time      `json:"end_time"`
+	Expired         bool           `json:"expired"`
+	Withdrawn       bool           `json:"withdrawn"`
+	Revision        int            `json:"revision"`
+	InterestRate    sdk.Dec        `json:"interest_rate"`
+	AutoRenew       bool           `json:"auto_renew"`
+	Compound        bool           `json:"compound"`
+	PreviousStakeID uint64         `json:"previous_stake_id,omitempty"`
+	Result          *RewardResult  `json:"result,omitempty"`
 }
 
 func (s Stake) String() string {
@@ -102,26 +132,106 @@ func (s Stake) String() string {
 }
 
 type Argument struct {
-	ID             uint64         `json:"id"`
-	Creator        sdk.AccAddress `json:"creator"`
-	ClaimID        uint64         `json:"claim_id"`
-	CommunityID    string         `json:"community_id"`
-	Summary        string         `json:"summary"`
-	Body           string         `json:"body"`
-	StakeType      StakeType      `json:"stake_type"`
-	UpvotedCount   int            `json:"upvoted_count"`
-	UpvotedStake   sdk.Coin       `json:"upvoted_stake"`
-	TotalStake     sdk.Coin       `json:"total_stake"`
-	DownvotedCount int            `json:"downvoted_count"`
-	IsUnhelpful    bool           `json:"is_unhelpful"`
-	CreatedTime    time.Time      `json:"created_time"`
-	UpdatedTime    time.Time      `json:"updated_time"`
-	EditedTime     time.Time      `json:"edited_time"`
-	Edited         bool           `json:"edited"`
+	ID               uint64         `json:"id"`
+	Creator          sdk.AccAddress `json:"creator"`
+	ClaimID          uint64         `json:"claim_id"`
+	CommunityID      string         `json:"community_id"`
+	ParentArgumentID uint64         `json:"parent_argument_id"`
+	Summary          string         `json:"summary"`
+	Body             string         `json:"body"`
+	StakeType        StakeType      `json:"stake_type"`
+	UpvotedCount     int            `json:"upvoted_count"`
+	UpvotedStake     sdk.Coin       `json:"upvoted_stake"`
+	TotalStake       sdk.Coin       `json:"total_stake"`
+	DownvotedCount   int            `json:"downvoted_count"`
+	DownvotedStake   sdk.Coin       `json:"downvoted_stake"`
+	IsUnhelpful      bool           `json:"is_unhelpful"`
+	CreatedTime      time.Time      `json:"created_time"`
+	UpdatedTime      time.Time      `json:"updated_time"`
+	EditedTime       time.Time      `json:"edited_time"`
+	Edited           bool           `json:"edited"`
+	Deleted          bool           `json:"deleted"`
+	DeletedTime      time.Time      `json:"deleted_time"`
+	RevisionCount    int            `json:"revision_count"`
 }
 
+// ArgumentRevision is an immutable version of an argument's content.
+// Revision 0 is the argument as it was submitted, every edit adds the next revision.
+type ArgumentRevision struct {
+	ArgumentID uint64         `json:"argument_id"`
+	Revision   int            `json:"revision"`
+	Editor     sdk.AccAddress `json:"editor"`
+	EditedTime time.Time      `json:"edited_time"`
+	Summary    string         `json:"summary"`
+	Body       string         `json:"body"`
+	Hash       string         `json:"hash"`
+}
+
+func newArgumentRevision(argument Argument, revision int, editor sdk.AccAddress, editedTime time.Time) ArgumentRevision {
+	return ArgumentRevision{
+		ArgumentID: argument.ID,
+		Revision:   revision,
+		Editor:     editor,
+		EditedTime: editedTime,
+		Summary:    argument.Summary,
+		Body:       argument.Body,
+		Hash:       argumentHash(argument.Summary, argument.Body),
+	}
+}
+
+// argumentHash is the hex encoded sha256 of an argument's summary and body
+func argumentHash(summary, body string) string {
+	hash := sha256.Sum256([]byte(summary + "\n" + body))
+	return hex.EncodeToString(hash[:])
+}
+
+// StakeLimitUpgrade records a user reaching a new stake tier.
+// NewLimit is the max stake of the new tier in whole TRU.
 type StakeLimitUpgrade struct {
+	Address      sdk.AccAddress `json:"address"`
+	NewLimit     int            `json:"new_limit"`
+	EarnedStake  sdk.Coin       `json:"earned_stake"`
+	Tier         int            `json:"tier"`
+	UpgradedTime time.Time      `json:"upgraded_time"`
+}
+
+// StakeTier caps the amount a user can stake in a period once they've earned EarnedThreshold.
+type StakeTier struct {
+	EarnedThreshold sdk.Int `json:"earned_threshold"`
+	MaxStake        sdk.Int `json:"max_stake"`
+}
+
+// UserStakeLimit describes the stake tier a user is in and how much they can still stake.
+type UserStakeLimit struct {
+	Address   sdk.AccAddress `json:"address"`
+	Tier      int            `json:"tier"`
+	MaxStake  sdk.Coin       `json:"max_stake"`
+	Staked    sdk.Coin       `json:"staked"`
+	Remaining sdk.Coin       `json:"remaining"`
+}
+
+// CommunityStakingParams overrides the global staking params for a single community.
+// Only the params listed in Overrides are used, the rest fall back to the global params.
+type CommunityStakingParams struct {
+	CommunityID           string        `json:"community_id"`
+	Period                time.Duration `json:"period"`
+	ArgumentCreationStake sdk.Coin      `json:"argument_creation_stake"`
+	UpvoteStake           sdk.Coin      `json:"upvote_stake"`
+	CreatorShare          sdk.Dec       `json:"creator_share"`
+	InterestRate          sdk.Dec       `json:"interest_rate"`
+	Overrides             []string      `json:"overrides"`
+}
+
+// FailedStakePayout is an entry of the dead-letter queue of stakes that couldn't be paid out when they expired.
+type FailedStakePayout struct {
+	StakeID    uint64    `json:"stake_id"`
+	Error      string    `json:"error"`
+	FailedTime time.Time `json:"failed_time"`
+}
+
+// UnjailUpvotes tracks the upvotes a jailed user received during a jail term
+type UnjailUpvotes struct {
 	Address     sdk.AccAddress `json:"address"`
-	NewLimit    int            `json:"new_limit"`
-	EarnedStake sdk.Coin       `json:"earned_stake"`
+	JailEndTime time.Time      `json:"jail_end_time"`
+	Upvotes     int            `json:"upvotes"`
 }